@@ -0,0 +1,132 @@
+package dldataset
+
+import (
+	"io"
+
+	context "context"
+
+	"github.com/pkg/errors"
+)
+
+// BatchPolicy determines how the final partial batch of a dataset is handled
+type BatchPolicy int
+
+const (
+	// BatchShort emits the final partial batch with fewer elements
+	BatchShort BatchPolicy = iota
+	// BatchDrop drops the final partial batch
+	BatchDrop
+	// BatchPad fills the final partial batch by repeating its last element
+	BatchPad
+)
+
+// Batch ...
+type Batch struct {
+	Data []LabeledData
+	// Padding is the number of elements at the end of Data that were added
+	// to fill the batch when using BatchPad
+	Padding int
+}
+
+// Len returns the number of elements in the batch that are not padding
+func (b *Batch) Len() int {
+	return len(b.Data) - b.Padding
+}
+
+// BatchOption ...
+type BatchOption func(*Batcher)
+
+// PartialBatchPolicy sets how the final partial batch is handled. The default is BatchShort.
+func PartialBatchPolicy(policy BatchPolicy) BatchOption {
+	return func(b *Batcher) {
+		b.policy = policy
+	}
+}
+
+// Batcher groups the elements returned by a dataset's Next into batches
+type Batcher struct {
+	dataset Dataset
+	size    int
+	policy  BatchPolicy
+	done    bool
+}
+
+// NewBatcher ...
+func NewBatcher(dataset Dataset, size int, opts ...BatchOption) (*Batcher, error) {
+	if size <= 0 {
+		return nil, errors.Errorf("the batch size %d must be positive", size)
+	}
+	b := &Batcher{
+		dataset: dataset,
+		size:    size,
+		policy:  BatchShort,
+	}
+	for _, o := range opts {
+		o(b)
+	}
+	return b, nil
+}
+
+// NextBatch returns the next batch from the dataset. It returns io.EOF once the
+// dataset is exhausted.
+func (b *Batcher) NextBatch(ctx context.Context) (*Batch, error) {
+	if b.done {
+		return nil, io.EOF
+	}
+	data := make([]LabeledData, 0, b.size)
+	for len(data) < b.size {
+		elem, err := b.dataset.Next(ctx)
+		if IsEOF(err) {
+			b.done = true
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, elem)
+	}
+
+	if len(data) == 0 {
+		return nil, io.EOF
+	}
+	if len(data) == b.size {
+		return &Batch{Data: data}, nil
+	}
+
+	switch b.policy {
+	case BatchDrop:
+		return nil, io.EOF
+	case BatchPad:
+		padding := b.size - len(data)
+		last := data[len(data)-1]
+		for len(data) < b.size {
+			data = append(data, last)
+		}
+		return &Batch{Data: data, Padding: padding}, nil
+	default:
+		return &Batch{Data: data}, nil
+	}
+}
+
+// Reset rewinds the underlying dataset so that batching starts over
+func (b *Batcher) Reset(ctx context.Context) error {
+	if err := b.dataset.Reset(ctx); err != nil {
+		return err
+	}
+	b.done = false
+	return nil
+}
+
+// NextBatch returns up to n elements from the dataset's Next. The final batch
+// may have fewer than n elements, and io.EOF is returned once the dataset is exhausted.
+func NextBatch(ctx context.Context, dataset Dataset, n int) ([]LabeledData, error) {
+	b, err := NewBatcher(dataset, n)
+	if err != nil {
+		return nil, err
+	}
+	batch, err := b.NextBatch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return batch.Data, nil
+}
@@ -0,0 +1,62 @@
+package dldataset
+
+import (
+	"io"
+	"testing"
+
+	context "context"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatcher(t *testing.T) {
+	ctx := context.Background()
+
+	policies := map[BatchPolicy][][]string{
+		BatchShort: {{"0", "1", "2"}, {"3", "4", "5"}, {"6"}},
+		BatchDrop:  {{"0", "1", "2"}, {"3", "4", "5"}},
+		BatchPad:   {{"0", "1", "2"}, {"3", "4", "5"}, {"6", "6", "6"}},
+	}
+
+	for policy, expected := range policies {
+		batcher, err := NewBatcher(newTestDataset(7), 3, PartialBatchPolicy(policy))
+		assert.NoError(t, err)
+
+		batches := [][]string{}
+		for {
+			batch, err := batcher.NextBatch(ctx)
+			if err == io.EOF {
+				break
+			}
+			assert.NoError(t, err)
+			batches = append(batches, labels(batch.Data))
+			if policy == BatchPad && len(batches) == 3 {
+				assert.Equal(t, 2, batch.Padding)
+				assert.Equal(t, 1, batch.Len())
+			}
+		}
+		assert.Equal(t, expected, batches)
+
+		err = batcher.Reset(ctx)
+		assert.NoError(t, err)
+		batch, err := batcher.NextBatch(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, expected[0], labels(batch.Data))
+	}
+}
+
+func TestNextBatch(t *testing.T) {
+	ctx := context.Background()
+	dataset := newTestDataset(3)
+
+	batch, err := NextBatch(ctx, dataset, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0", "1"}, labels(batch))
+
+	batch, err = NextBatch(ctx, dataset, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2"}, labels(batch))
+
+	_, err = NextBatch(ctx, dataset, 2)
+	assert.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,79 @@
+package dldataset
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Checksum is the expected digest of a downloaded file. The SHA-256 digest is
+// checked when it is known, otherwise the MD5 digest is.
+type Checksum struct {
+	SHA256 string `json:"sha256,omitempty" mapstructure:"sha256"`
+	MD5    string `json:"md5,omitempty" mapstructure:"md5"`
+}
+
+// IsZero reports whether neither digest is known
+func (c Checksum) IsZero() bool {
+	return c.SHA256 == "" && c.MD5 == ""
+}
+
+// FileChecksum computes the SHA-256 and MD5 digests of the file in a single pass
+func FileChecksum(path string) (Checksum, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Checksum{}, errors.Wrapf(err, "cannot open %v", path)
+	}
+	defer f.Close()
+
+	sha256Hash, md5Hash := sha256.New(), md5.New()
+	if _, err := io.Copy(io.MultiWriter(sha256Hash, md5Hash), f); err != nil {
+		return Checksum{}, errors.Wrapf(err, "cannot read %v", path)
+	}
+	return Checksum{
+		SHA256: hex.EncodeToString(sha256Hash.Sum(nil)),
+		MD5:    hex.EncodeToString(md5Hash.Sum(nil)),
+	}, nil
+}
+
+// VerifyFile checks that the file exists and matches the checksum. If the checksum is
+// zero then only the existence of the file is checked. The error wraps ErrNotDownloaded
+// if the file is missing or empty, and ErrChecksumMismatch if it does not match.
+func VerifyFile(path string, checksum Checksum) error {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return errors.Wrapf(ErrNotDownloaded, "the file %v does not exist", path)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "cannot stat %v", path)
+	}
+	if info.IsDir() {
+		return errors.Wrapf(ErrNotDownloaded, "the path %v is a directory", path)
+	}
+	if info.Size() == 0 {
+		return errors.Wrapf(ErrNotDownloaded, "the file %v is empty", path)
+	}
+	if checksum.IsZero() {
+		return nil
+	}
+
+	actual, err := FileChecksum(path)
+	if err != nil {
+		return err
+	}
+	if checksum.SHA256 != "" {
+		if !strings.EqualFold(actual.SHA256, checksum.SHA256) {
+			return errors.Wrapf(ErrChecksumMismatch, "the sha256 sum %v of %v did not match the expected %v", actual.SHA256, path, checksum.SHA256)
+		}
+		return nil
+	}
+	if !strings.EqualFold(actual.MD5, checksum.MD5) {
+		return errors.Wrapf(ErrChecksumMismatch, "the md5 sum %v of %v did not match the expected %v", actual.MD5, path, checksum.MD5)
+	}
+	return nil
+}
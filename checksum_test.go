@@ -0,0 +1,40 @@
+package dldataset
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "data.bin")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("hello world"), 0644))
+
+	checksum, err := FileChecksum(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9", checksum.SHA256)
+	assert.Equal(t, "5eb63bbbe01eeed093cb22bb8f5acdc3", checksum.MD5)
+
+	assert.NoError(t, VerifyFile(path, Checksum{}))
+	assert.NoError(t, VerifyFile(path, Checksum{MD5: "5EB63BBBE01EEED093CB22BB8F5ACDC3"}))
+	assert.NoError(t, VerifyFile(path, checksum))
+
+	// the sha256 sum is preferred over the md5 sum
+	err = VerifyFile(path, Checksum{SHA256: "0000", MD5: checksum.MD5})
+	assert.True(t, IsChecksumMismatch(err))
+	assert.True(t, IsChecksumMismatch(VerifyFile(path, Checksum{MD5: "0000"})))
+
+	assert.True(t, IsNotDownloaded(VerifyFile(filepath.Join(dir, "missing.bin"), checksum)))
+	assert.True(t, IsNotDownloaded(VerifyFile(dir, Checksum{})))
+
+	empty := filepath.Join(dir, "empty.bin")
+	assert.NoError(t, ioutil.WriteFile(empty, nil, 0644))
+	assert.True(t, IsNotDownloaded(VerifyFile(empty, Checksum{})))
+}
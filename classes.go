@@ -0,0 +1,68 @@
+package dldataset
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Class is an entry of a dataset's class vocabulary
+type Class struct {
+	// Index is the index of the class in the output of the models evaluated on the
+	// dataset. The indices of a dataset are not necessarily contiguous.
+	Index int `json:"index"`
+	// Name is the human readable name of the class
+	Name string `json:"name"`
+	// ID is the identifier of the class in the dataset's label files, for example an
+	// ImageNet synset, if it differs from the name
+	ID string `json:"id,omitempty"`
+}
+
+// ClassMap looks up the classes of a dataset by index and by name
+type ClassMap struct {
+	classes []Class
+	byIndex map[int]Class
+	byName  map[string]int
+}
+
+// NewClassMap ...
+func NewClassMap(classes []Class) *ClassMap {
+	m := &ClassMap{
+		classes: classes,
+		byIndex: make(map[int]Class, len(classes)),
+		byName:  make(map[string]int, 2*len(classes)),
+	}
+	for _, class := range classes {
+		m.byIndex[class.Index] = class
+		if class.ID != "" {
+			m.byName[class.ID] = class.Index
+			m.byName[class.ID+" "+class.Name] = class.Index
+		}
+		m.byName[class.Name] = class.Index
+	}
+	return m
+}
+
+// Classes returns a copy of the classes ordered by index
+func (m *ClassMap) Classes() []Class {
+	classes := make([]Class, len(m.classes))
+	copy(classes, m.classes)
+	return classes
+}
+
+// LabelIndex returns the index of the class with the given name or id. The id followed by
+// a space and the name, as found in the ImageNet synset file, is also accepted.
+func (m *ClassMap) LabelIndex(name string) (int, error) {
+	index, ok := m.byName[name]
+	if !ok {
+		return 0, errors.Wrapf(ErrNotFound, "unable to find the class %s", name)
+	}
+	return index, nil
+}
+
+// LabelName returns the name of the class with the given index
+func (m *ClassMap) LabelName(index int) (string, error) {
+	class, ok := m.byIndex[index]
+	if !ok {
+		return "", errors.Wrapf(ErrNotFound, "unable to find the class with index %d", index)
+	}
+	return class.Name, nil
+}
@@ -0,0 +1,60 @@
+package dldataset
+
+import (
+	"testing"
+
+	context "context"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassMap(t *testing.T) {
+	classes := NewClassMap([]Class{
+		{Index: 1, Name: "person", ID: "/m/01g317"},
+		{Index: 3, Name: "car", ID: "/m/0k4j"},
+	})
+
+	index, err := classes.LabelIndex("car")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, index)
+
+	index, err = classes.LabelIndex("/m/01g317")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, index)
+
+	index, err = classes.LabelIndex("/m/01g317 person")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, index)
+
+	_, err = classes.LabelIndex("bicycle")
+	assert.Error(t, err)
+
+	name, err := classes.LabelName(3)
+	assert.NoError(t, err)
+	assert.Equal(t, "car", name)
+
+	_, err = classes.LabelName(2)
+	assert.Error(t, err)
+
+	// the classes cannot be modified through the returned slice
+	classes.Classes()[1].Name = "bicycle"
+	name, err = classes.LabelName(3)
+	assert.NoError(t, err)
+	assert.Equal(t, "car", name)
+	assert.Equal(t, "car", classes.Classes()[1].Name)
+}
+
+func TestCombinedClasses(t *testing.T) {
+	ctx := context.Background()
+
+	ds, err := Concat(newNamedTestDataset("a", 3), newNamedTestDataset("b", 3))
+	assert.NoError(t, err)
+	name, err := ds.LabelName(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", name)
+
+	ds, err = Concat(newNamedTestDataset("a", 3), newNamedTestDataset("b", 4))
+	assert.NoError(t, err)
+	_, err = ds.Classes(ctx)
+	assert.Error(t, err)
+}
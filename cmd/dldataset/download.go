@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	context "context"
+
+	"github.com/rai-project/dldataset"
+	"github.com/spf13/cobra"
+)
+
+var downloadProgress bool
+
+var downloadCmd = &cobra.Command{
+	Use:   "download <name>",
+	Short: "Downloads a dataset to the working directory",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dataset, err := getDataset(args[0])
+		if err != nil {
+			return err
+		}
+		ctx := context.Background()
+		if downloadProgress {
+			ctx = dldataset.WithProgress(ctx, printProgress)
+		}
+		if err := dataset.Download(ctx); err != nil {
+			return err
+		}
+		fmt.Printf("downloaded %s\n", dataset.CanonicalName())
+		return nil
+	},
+}
+
+// formatProgress formats the progress of a download, for example
+// "imagenet1k-val.rec: 1.5 GiB of 6.2 GiB (24%) at 12.0 MiB/s, 6m40s left"
+func formatProgress(progress dldataset.DownloadProgress) string {
+	rate := formatBytes(int64(progress.Rate)) + "/s"
+	if progress.Done {
+		return fmt.Sprintf("%s: %s at %s, done", progress.FileName, formatBytes(progress.BytesDone), rate)
+	}
+	if progress.BytesTotal <= 0 {
+		return fmt.Sprintf("%s: %s at %s", progress.FileName, formatBytes(progress.BytesDone), rate)
+	}
+	return fmt.Sprintf("%s: %s of %s (%.0f%%) at %s, %v left",
+		progress.FileName,
+		formatBytes(progress.BytesDone),
+		formatBytes(progress.BytesTotal),
+		100*progress.Fraction(),
+		rate,
+		progress.ETA.Round(time.Second),
+	)
+}
+
+func printProgress(progress dldataset.DownloadProgress) {
+	fmt.Fprintln(os.Stderr, formatProgress(progress))
+}
+
+func init() {
+	downloadCmd.Flags().BoolVar(&downloadProgress, "progress", true, "print the progress of the downloads to stderr")
+	rootCmd.AddCommand(downloadCmd)
+}
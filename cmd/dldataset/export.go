@@ -0,0 +1,103 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"image/png"
+	"os"
+	"path/filepath"
+
+	context "context"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/dldataset"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportCount      int
+	exportSkipErrors bool
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export <name> <dir>",
+	Short: "Writes the images of a dataset as png files and their labels to labels.csv",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		dataset, err := getDataset(args[0])
+		if err != nil {
+			return err
+		}
+		if err := dataset.Load(ctx); err != nil {
+			return err
+		}
+		defer dataset.Close()
+
+		var records dldataset.Dataset = dataset
+		if exportSkipErrors {
+			records = dldataset.WithErrorPolicy(dataset, dldataset.SkipAndLog())
+		}
+
+		outputDir := args[1]
+		if err := os.MkdirAll(outputDir, os.ModePerm); err != nil {
+			return errors.Wrapf(err, "cannot create %v", outputDir)
+		}
+		labelsFileName := filepath.Join(outputDir, "labels.csv")
+		labelsFile, err := os.Create(labelsFileName)
+		if err != nil {
+			return errors.Wrapf(err, "cannot create %v", labelsFileName)
+		}
+		defer labelsFile.Close()
+
+		labels := csv.NewWriter(labelsFile)
+		labels.Write([]string{"file", "id", "label"})
+
+		count := 0
+		for exportCount <= 0 || count < exportCount {
+			data, err := records.Next(ctx)
+			if dldataset.IsEOF(err) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			// the ids can contain slashes, so the files are named after their position
+			fileName := fmt.Sprintf("%06d.png", count)
+			if err := exportImage(filepath.Join(outputDir, fileName), data); err != nil {
+				return err
+			}
+			labels.Write([]string{fileName, data.Metadata().ID, data.Label()})
+			count++
+		}
+
+		labels.Flush()
+		if err := labels.Error(); err != nil {
+			return errors.Wrapf(err, "cannot write %v", labelsFileName)
+		}
+		fmt.Printf("exported %d records of %s to %s\n", count, dataset.CanonicalName(), outputDir)
+		return nil
+	},
+}
+
+func exportImage(fileName string, data dldataset.LabeledData) error {
+	img, err := getImage(data)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(fileName)
+	if err != nil {
+		return errors.Wrapf(err, "cannot create %v", fileName)
+	}
+	defer f.Close()
+	if err := png.Encode(f, img); err != nil {
+		return errors.Wrapf(err, "cannot encode %v", fileName)
+	}
+	return nil
+}
+
+func init() {
+	exportCmd.Flags().IntVarP(&exportCount, "n", "n", 0, "the number of records to export, all the records if it is not positive")
+	exportCmd.Flags().BoolVar(&exportSkipErrors, "skip-errors", false, "skip the records that cannot be read instead of failing")
+	rootCmd.AddCommand(exportCmd)
+}
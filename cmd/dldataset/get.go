@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"image/png"
+	"os"
+
+	context "context"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var getOutput string
+
+var getCmd = &cobra.Command{
+	Use:   "get <name> <id>",
+	Short: "Writes the image of a record as a png file",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		dataset, err := getDataset(args[0])
+		if err != nil {
+			return err
+		}
+		if err := dataset.Load(ctx); err != nil {
+			return err
+		}
+		defer dataset.Close()
+
+		data, err := dataset.Get(ctx, args[1])
+		if err != nil {
+			return err
+		}
+		img, err := getImage(data)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Create(getOutput)
+		if err != nil {
+			return errors.Wrapf(err, "cannot create %v", getOutput)
+		}
+		defer f.Close()
+		if err := png.Encode(f, img); err != nil {
+			return errors.Wrapf(err, "cannot encode %v", getOutput)
+		}
+		fmt.Printf("wrote %s with label %s to %s\n", args[1], data.Label(), getOutput)
+		return nil
+	},
+}
+
+func init() {
+	getCmd.Flags().StringVarP(&getOutput, "output", "o", "out.png", "the png file to write")
+	rootCmd.AddCommand(getCmd)
+}
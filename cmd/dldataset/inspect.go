@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+
+	context "context"
+
+	"github.com/rai-project/dldataset"
+	"github.com/spf13/cobra"
+)
+
+var inspectCount int
+
+var inspectCmd = &cobra.Command{
+	Use:   "inspect <name>",
+	Short: "Prints the labels and features of the first records of a dataset",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		dataset, err := getDataset(args[0])
+		if err != nil {
+			return err
+		}
+		if err := dataset.Load(ctx); err != nil {
+			return err
+		}
+		defer dataset.Close()
+
+		for ii := 0; ii < inspectCount; ii++ {
+			data, err := dataset.Next(ctx)
+			if dldataset.IsEOF(err) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			metadata := data.Metadata()
+			fmt.Printf("%d: id=%s label=%s", ii, metadata.ID, data.Label())
+			if metadata.Width != 0 && metadata.Height != 0 {
+				fmt.Printf(" size=%dx%d", metadata.Width, metadata.Height)
+			}
+			fmt.Println()
+			for _, feature := range data.Features() {
+				fmt.Printf("    %v\n", feature)
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	inspectCmd.Flags().IntVarP(&inspectCount, "n", "n", 10, "the number of records to print")
+	rootCmd.AddCommand(inspectCmd)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/rai-project/dldataset"
+	"github.com/spf13/cobra"
+)
+
+var listJSON bool
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the registered datasets and their info",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		infos := dldataset.DatasetInfos()
+		if listJSON {
+			enc := json.NewEncoder(os.Stdout)
+			enc.SetIndent("", "  ")
+			return enc.Encode(infos)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tTASK\tEXAMPLES\tCLASSES\tSIZE")
+		for _, info := range infos {
+			size := "-"
+			if info.DiskSize > 0 {
+				size = formatBytes(info.DiskSize)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n", info.CanonicalName, info.Task, info.NumExamples(), info.NumClasses, size)
+		}
+		return w.Flush()
+	},
+}
+
+func init() {
+	listCmd.Flags().BoolVar(&listJSON, "json", false, "print the info of the datasets as json")
+	rootCmd.AddCommand(listCmd)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/config"
+	"github.com/rai-project/dldataset"
+	_ "github.com/rai-project/dldataset/vision"
+	"github.com/spf13/cobra"
+)
+
+var (
+	configFileName string
+	isVerbose      bool
+	isDebug        bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "dldataset",
+	Short: "Lists, downloads, verifies and inspects the datasets registered with dldataset",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		config.Init(
+			config.AppName("carml"),
+			config.ConfigFileName(configFileName),
+			config.VerboseMode(isVerbose),
+			config.DebugMode(isDebug),
+		)
+	},
+	SilenceUsage: true,
+}
+
+// splitName splits a canonical name such as vision/cifar10 into its category and name.
+// The category defaults to vision.
+func splitName(canonicalName string) (string, string) {
+	if ii := strings.Index(canonicalName, "/"); ii != -1 {
+		return canonicalName[:ii], canonicalName[ii+1:]
+	}
+	return "vision", canonicalName
+}
+
+func getDataset(canonicalName string) (dldataset.Dataset, error) {
+	category, name := splitName(canonicalName)
+	return dldataset.Get(category, name)
+}
+
+// getImage returns the image of a record
+func getImage(data dldataset.LabeledData) (image.Image, error) {
+	val, err := data.Data()
+	if err != nil {
+		return nil, err
+	}
+	img, ok := val.(image.Image)
+	if !ok {
+		return nil, errors.Wrapf(dldataset.ErrNotSupported, "the data of %v is a %T rather than an image", data.Metadata().ID, val)
+	}
+	return img, nil
+}
+
+// formatBytes formats the number of bytes using binary units, for example 1.5 GiB
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&configFileName, "config", "", "the configuration file")
+	rootCmd.PersistentFlags().BoolVarP(&isVerbose, "verbose", "v", false, "print verbose output")
+	rootCmd.PersistentFlags().BoolVarP(&isDebug, "debug", "d", false, "print debug output")
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rai-project/dldataset"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitName(t *testing.T) {
+	category, name := splitName("vision/cifar10")
+	assert.Equal(t, "vision", category)
+	assert.Equal(t, "cifar10", name)
+
+	category, name = splitName("ilsvrc2012_validation")
+	assert.Equal(t, "vision", category)
+	assert.Equal(t, "ilsvrc2012_validation", name)
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512 B", formatBytes(512))
+	assert.Equal(t, "1.5 KiB", formatBytes(1536))
+	assert.Equal(t, "162.0 MiB", formatBytes(162*1024*1024))
+}
+
+func TestFormatProgress(t *testing.T) {
+	progress := dldataset.DownloadProgress{
+		FileName:   "imagenet1k-val.rec",
+		BytesDone:  512 * 1024 * 1024,
+		BytesTotal: 2048 * 1024 * 1024,
+		Rate:       4 * 1024 * 1024,
+		ETA:        384*time.Second + 300*time.Millisecond,
+	}
+	assert.Equal(t, "imagenet1k-val.rec: 512.0 MiB of 2.0 GiB (25%) at 4.0 MiB/s, 6m24s left", formatProgress(progress))
+
+	progress.BytesTotal = 0
+	assert.Equal(t, "imagenet1k-val.rec: 512.0 MiB at 4.0 MiB/s", formatProgress(progress))
+
+	progress.Done = true
+	assert.Equal(t, "imagenet1k-val.rec: 512.0 MiB at 4.0 MiB/s, done", formatProgress(progress))
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+
+	context "context"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/dldataset"
+	"github.com/spf13/cobra"
+)
+
+var (
+	verifyRecords    bool
+	requireChecksums bool
+)
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify <name>",
+	Short: "Checks the downloaded files of a dataset against their checksums",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := context.Background()
+		if requireChecksums {
+			dldataset.Config.RequireChecksums = true
+		}
+		dataset, err := getDataset(args[0])
+		if err != nil {
+			return err
+		}
+		if err := dataset.Verify(ctx); err != nil {
+			return err
+		}
+		fmt.Printf("verified the files of %s\n", dataset.CanonicalName())
+		if !verifyRecords {
+			return nil
+		}
+
+		if err := dataset.Load(ctx); err != nil {
+			return err
+		}
+		defer dataset.Close()
+
+		report := &dldataset.ErrorReport{}
+		records := dldataset.WithErrorPolicy(dataset, dldataset.SkipAndReport(report))
+		count := 0
+		for {
+			_, err := records.Next(ctx)
+			if dldataset.IsEOF(err) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			count++
+		}
+
+		for _, skipped := range report.Skipped() {
+			fmt.Printf("record %d: %v\n", skipped.Index, skipped.Err)
+		}
+		if report.Len() != 0 {
+			return errors.Wrapf(dldataset.ErrCorruptRecord, "%d of the %d records of %s cannot be read", report.Len(), count+report.Len(), dataset.CanonicalName())
+		}
+		fmt.Printf("verified the %d records of %s\n", count, dataset.CanonicalName())
+		return nil
+	},
+}
+
+func init() {
+	verifyCmd.Flags().BoolVar(&verifyRecords, "records", false, "also read every record and report the records that cannot be read")
+	verifyCmd.Flags().BoolVar(&requireChecksums, "require-checksums", false, "fail on the files that have no recorded checksum instead of only checking that they exist")
+	rootCmd.AddCommand(verifyCmd)
+}
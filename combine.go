@@ -0,0 +1,434 @@
+package dldataset
+
+import (
+	"io"
+	"math/rand"
+	"reflect"
+	"strings"
+
+	context "context"
+
+	"github.com/pkg/errors"
+)
+
+// SourcedData is implemented by the records returned by the combined datasets
+type SourcedData interface {
+	// Source returns the canonical name of the dataset the record was read from
+	Source() string
+}
+
+type sourcedData struct {
+	LabeledData
+	source string
+}
+
+// Source ...
+func (l sourcedData) Source() string {
+	return l.source
+}
+
+// Source returns the canonical name of the dataset the record was read from,
+// or an empty string if the record was not read through a combined dataset
+func Source(data LabeledData) string {
+	if sourced, ok := data.(SourcedData); ok {
+		return sourced.Source()
+	}
+	return ""
+}
+
+type combineMode string
+
+const (
+	concatMode     combineMode = "concat"
+	interleaveMode combineMode = "interleave"
+	mixMode        combineMode = "mix"
+)
+
+// combinedDataset reads from several datasets according to a schedule that lists the
+// index of the dataset each record of Next is read from. The schedule is computed from
+// the lengths of the datasets, so List, Len and Seek agree with Next.
+type combinedDataset struct {
+	datasets []Dataset
+	mode     combineMode
+	weights  []float64
+	seed     int64
+	schedule []int
+	cursor   int
+	ready    bool
+}
+
+// Concat returns a dataset whose Next returns all the records of the first dataset,
+// then all the records of the second dataset, and so on
+func Concat(datasets ...Dataset) (Dataset, error) {
+	return newCombinedDataset(concatMode, datasets, nil, 0)
+}
+
+// Interleave returns a dataset whose Next takes one record from each dataset in turn.
+// Datasets that are exhausted are skipped.
+func Interleave(datasets ...Dataset) (Dataset, error) {
+	return newCombinedDataset(interleaveMode, datasets, nil, 0)
+}
+
+// Mix returns a dataset whose Next picks the dataset to read the next record from at
+// random, in proportion to the weights. Once a dataset is exhausted the remaining
+// datasets are picked in proportion to their weights, so every record is returned once.
+// The same seed always gives the same order.
+func Mix(datasets []Dataset, weights []float64, seed int64) (Dataset, error) {
+	if len(weights) != len(datasets) {
+		return nil, errors.Errorf("the number of weights %d does not match the number of datasets %d", len(weights), len(datasets))
+	}
+	for _, weight := range weights {
+		if weight <= 0 {
+			return nil, errors.Errorf("the mix weight %v must be positive", weight)
+		}
+	}
+	return newCombinedDataset(mixMode, datasets, weights, seed)
+}
+
+func newCombinedDataset(mode combineMode, datasets []Dataset, weights []float64, seed int64) (Dataset, error) {
+	if len(datasets) == 0 {
+		return nil, errors.New("at least one dataset is required")
+	}
+	seen := map[string]bool{}
+	for _, dataset := range datasets {
+		name := dataset.CanonicalName()
+		if seen[name] {
+			return nil, errors.Errorf("the %s dataset is used more than once", name)
+		}
+		seen[name] = true
+	}
+	return &combinedDataset{
+		datasets: datasets,
+		mode:     mode,
+		weights:  weights,
+		seed:     seed,
+	}, nil
+}
+
+func (d *combinedDataset) init(ctx context.Context) error {
+	if d.ready {
+		return nil
+	}
+	lengths := make([]int, len(d.datasets))
+	for ii, dataset := range d.datasets {
+		length, err := dataset.Len(ctx)
+		if err != nil {
+			return err
+		}
+		lengths[ii] = length
+	}
+	d.schedule = d.newSchedule(lengths)
+	if err := d.seek(ctx, 0); err != nil {
+		return err
+	}
+	d.ready = true
+	return nil
+}
+
+func (d *combinedDataset) newSchedule(lengths []int) []int {
+	total := 0
+	for _, length := range lengths {
+		total += length
+	}
+	schedule := make([]int, 0, total)
+	remaining := append([]int{}, lengths...)
+
+	switch d.mode {
+	case concatMode:
+		for ii, length := range lengths {
+			for jj := 0; jj < length; jj++ {
+				schedule = append(schedule, ii)
+			}
+		}
+	case interleaveMode:
+		for len(schedule) < total {
+			for ii := range remaining {
+				if remaining[ii] > 0 {
+					schedule = append(schedule, ii)
+					remaining[ii]--
+				}
+			}
+		}
+	case mixMode:
+		rng := rand.New(rand.NewSource(d.seed))
+		for len(schedule) < total {
+			sum := 0.0
+			for ii, weight := range d.weights {
+				if remaining[ii] > 0 {
+					sum += weight
+				}
+			}
+			pick, last := rng.Float64()*sum, 0
+			for ii, weight := range d.weights {
+				if remaining[ii] == 0 {
+					continue
+				}
+				last = ii
+				if pick < weight {
+					break
+				}
+				pick -= weight
+			}
+			schedule = append(schedule, last)
+			remaining[last]--
+		}
+	}
+	return schedule
+}
+
+func (d *combinedDataset) seek(ctx context.Context, index int) error {
+	offsets := make([]int, len(d.datasets))
+	for _, ii := range d.schedule[:index] {
+		offsets[ii]++
+	}
+	for ii, dataset := range d.datasets {
+		if err := dataset.Seek(ctx, offsets[ii]); err != nil {
+			return err
+		}
+	}
+	d.cursor = index
+	return nil
+}
+
+func (d *combinedDataset) source(ii int, data LabeledData) LabeledData {
+	// records that were already tagged by a nested combined dataset keep their original source
+	if _, ok := data.(SourcedData); ok {
+		return data
+	}
+	return sourcedData{
+		LabeledData: data,
+		source:      d.datasets[ii].CanonicalName(),
+	}
+}
+
+// New ...
+func (d *combinedDataset) New(ctx context.Context) (Dataset, error) {
+	datasets := make([]Dataset, len(d.datasets))
+	for ii, dataset := range d.datasets {
+		instance, err := dataset.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		datasets[ii] = instance
+	}
+	return newCombinedDataset(d.mode, datasets, d.weights, d.seed)
+}
+
+// Category returns the category shared by the datasets, or "combined" if they differ
+func (d *combinedDataset) Category() string {
+	category := d.datasets[0].Category()
+	for _, dataset := range d.datasets[1:] {
+		if !strings.EqualFold(dataset.Category(), category) {
+			return "combined"
+		}
+	}
+	return category
+}
+
+// Name ...
+func (d *combinedDataset) Name() string {
+	names := make([]string, len(d.datasets))
+	for ii, dataset := range d.datasets {
+		names[ii] = dataset.CanonicalName()
+	}
+	return string(d.mode) + "(" + strings.Join(names, ",") + ")"
+}
+
+// CanonicalName ...
+func (d *combinedDataset) CanonicalName() string {
+	return strings.ToLower(d.Category()) + "/" + strings.ToLower(d.Name())
+}
+
+// Info ...
+func (d *combinedDataset) Info() DatasetInfo {
+	info := DatasetInfo{
+		CanonicalName: d.CanonicalName(),
+		Category:      d.Category(),
+		Name:          d.Name(),
+		Splits:        map[string]int{},
+	}
+	for ii, dataset := range d.datasets {
+		sourceInfo := dataset.Info()
+		if ii == 0 {
+			info.Task = sourceInfo.Task
+			info.NumClasses = sourceInfo.NumClasses
+		}
+		if info.Task != sourceInfo.Task {
+			info.Task = ""
+		}
+		if info.NumClasses != sourceInfo.NumClasses {
+			info.NumClasses = 0
+		}
+		for split, count := range sourceInfo.Splits {
+			info.Splits[split] += count
+		}
+		info.DiskSize += sourceInfo.DiskSize
+		info.URLs = append(info.URLs, sourceInfo.URLs...)
+	}
+	return info
+}
+
+// Download ...
+func (d *combinedDataset) Download(ctx context.Context) error {
+	for _, dataset := range d.datasets {
+		if err := dataset.Download(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Verify ...
+func (d *combinedDataset) Verify(ctx context.Context) error {
+	for _, dataset := range d.datasets {
+		if err := dataset.Verify(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// List returns the names of the records prefixed by the canonical name of their dataset
+func (d *combinedDataset) List(ctx context.Context, opts ...ListOption) ([]string, error) {
+	if err := d.init(ctx); err != nil {
+		return nil, err
+	}
+	lists := make([][]string, len(d.datasets))
+	for ii, dataset := range d.datasets {
+		names, err := dataset.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		lists[ii] = names
+	}
+	offsets := make([]int, len(d.datasets))
+	names := make([]string, 0, len(d.schedule))
+	for _, ii := range d.schedule {
+		if offsets[ii] >= len(lists[ii]) {
+			return nil, errors.Errorf("the %s dataset lists fewer records than its length", d.datasets[ii].CanonicalName())
+		}
+		names = append(names, d.datasets[ii].CanonicalName()+"/"+lists[ii][offsets[ii]])
+		offsets[ii]++
+	}
+	return ApplyListOptions(names, opts...)
+}
+
+// Load ...
+func (d *combinedDataset) Load(ctx context.Context) error {
+	for _, dataset := range d.datasets {
+		if err := dataset.Load(ctx); err != nil {
+			return err
+		}
+	}
+	d.ready = false
+	return d.init(ctx)
+}
+
+// Get accepts the names returned by List
+func (d *combinedDataset) Get(ctx context.Context, name string) (LabeledData, error) {
+	for ii, dataset := range d.datasets {
+		prefix := dataset.CanonicalName() + "/"
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		data, err := dataset.Get(ctx, strings.TrimPrefix(name, prefix))
+		if err != nil {
+			return nil, err
+		}
+		return d.source(ii, data), nil
+	}
+	return nil, errors.Wrapf(ErrNotFound, "unable to find %s in the %s dataset", name, d.CanonicalName())
+}
+
+// Next ...
+func (d *combinedDataset) Next(ctx context.Context) (LabeledData, error) {
+	if err := d.init(ctx); err != nil {
+		return nil, err
+	}
+	for d.cursor < len(d.schedule) {
+		ii := d.schedule[d.cursor]
+		data, err := d.datasets[ii].Next(ctx)
+		if IsEOF(err) {
+			// the dataset returned fewer records than its length
+			d.cursor++
+			continue
+		}
+		d.cursor++
+		if err != nil {
+			return nil, err
+		}
+		return d.source(ii, data), nil
+	}
+	return nil, io.EOF
+}
+
+// Len ...
+func (d *combinedDataset) Len(ctx context.Context) (int, error) {
+	if err := d.init(ctx); err != nil {
+		return 0, err
+	}
+	return len(d.schedule), nil
+}
+
+// Reset ...
+func (d *combinedDataset) Reset(ctx context.Context) error {
+	return d.Seek(ctx, 0)
+}
+
+// Seek ...
+func (d *combinedDataset) Seek(ctx context.Context, index int) error {
+	if err := d.init(ctx); err != nil {
+		return err
+	}
+	if index < 0 || index > len(d.schedule) {
+		return errors.Errorf("the index %d is out of range %d", index, len(d.schedule))
+	}
+	return d.seek(ctx, index)
+}
+
+// Classes returns the classes shared by the datasets. It fails if the datasets have different classes.
+func (d *combinedDataset) Classes(ctx context.Context) ([]Class, error) {
+	classes, err := d.datasets[0].Classes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, dataset := range d.datasets[1:] {
+		other, err := dataset.Classes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(classes, other) {
+			return nil, errors.Wrapf(ErrNotSupported, "the classes of the %s and %s datasets differ", d.datasets[0].CanonicalName(), dataset.CanonicalName())
+		}
+	}
+	return classes, nil
+}
+
+// LabelIndex ...
+func (d *combinedDataset) LabelIndex(ctx context.Context, name string) (int, error) {
+	classes, err := d.Classes(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return NewClassMap(classes).LabelIndex(name)
+}
+
+// LabelName ...
+func (d *combinedDataset) LabelName(ctx context.Context, index int) (string, error) {
+	classes, err := d.Classes(ctx)
+	if err != nil {
+		return "", err
+	}
+	return NewClassMap(classes).LabelName(index)
+}
+
+// Close ...
+func (d *combinedDataset) Close() error {
+	var err error
+	for _, dataset := range d.datasets {
+		if e := dataset.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
@@ -0,0 +1,85 @@
+package dldataset
+
+import (
+	"testing"
+
+	context "context"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sources(ctx context.Context, t *testing.T, dataset Dataset) []string {
+	res := []string{}
+	for {
+		data, err := dataset.Next(ctx)
+		if IsEOF(err) {
+			return res
+		}
+		assert.NoError(t, err)
+		res = append(res, Source(data)+":"+data.Label())
+	}
+}
+
+func TestConcat(t *testing.T) {
+	ctx := context.Background()
+
+	ds, err := Concat(newNamedTestDataset("a", 2), newNamedTestDataset("b", 3))
+	assert.NoError(t, err)
+
+	expected := []string{"test/a:0", "test/a:1", "test/b:0", "test/b:1", "test/b:2"}
+	assert.Equal(t, expected, sources(ctx, t, ds))
+
+	names, err := ds.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test/a/0", "test/a/1", "test/b/0", "test/b/1", "test/b/2"}, names)
+
+	data, err := ds.Get(ctx, "test/b/1")
+	assert.NoError(t, err)
+	assert.Equal(t, "test/b", Source(data))
+	assert.Equal(t, "1", data.Label())
+
+	assert.NoError(t, ds.Seek(ctx, 3))
+	assert.Equal(t, expected[3:], sources(ctx, t, ds))
+
+	_, err = Concat(newNamedTestDataset("a", 2), newNamedTestDataset("a", 3))
+	assert.Error(t, err)
+}
+
+func TestInterleave(t *testing.T) {
+	ctx := context.Background()
+
+	ds, err := Interleave(newNamedTestDataset("a", 3), newNamedTestDataset("b", 1))
+	assert.NoError(t, err)
+
+	expected := []string{"test/a:0", "test/b:0", "test/a:1", "test/a:2"}
+	assert.Equal(t, expected, sources(ctx, t, ds))
+
+	length, err := ds.Len(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, length)
+
+	assert.NoError(t, ds.Reset(ctx))
+	assert.Equal(t, expected, sources(ctx, t, ds))
+}
+
+func TestMix(t *testing.T) {
+	ctx := context.Background()
+
+	newMix := func(seed int64) Dataset {
+		ds, err := Mix([]Dataset{newNamedTestDataset("a", 50), newNamedTestDataset("b", 50)}, []float64{3, 1}, seed)
+		assert.NoError(t, err)
+		return ds
+	}
+
+	first := sources(ctx, t, newMix(1))
+	assert.Len(t, first, 100)
+	assert.Equal(t, first, sources(ctx, t, newMix(1)))
+	assert.NotEqual(t, first, sources(ctx, t, newMix(2)))
+
+	ds := newMix(1)
+	assert.NoError(t, ds.Seek(ctx, 40))
+	assert.Equal(t, first[40:], sources(ctx, t, ds))
+
+	_, err := Mix([]Dataset{newNamedTestDataset("a", 1)}, []float64{0}, 1)
+	assert.Error(t, err)
+}
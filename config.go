@@ -1,14 +1,68 @@
 package dldataset
 
 import (
+	"strings"
+
 	"github.com/k0kubun/pp"
 	"github.com/rai-project/config"
+	"github.com/rai-project/logger"
 	"github.com/rai-project/vipertags"
+	"github.com/spf13/viper"
 )
 
 type dldatasetConfig struct {
-	WorkingDirectory string        `json:"working_directory" config:"dldataset.working_directory" default:""`
-	done             chan struct{} `json:"-" config:"-"`
+	WorkingDirectory   string `json:"working_directory" config:"dldataset.working_directory" default:""`
+	PrefetchWorkers    int    `json:"prefetch_workers" config:"dldataset.prefetch_workers" default:"0"`
+	PrefetchBufferSize int    `json:"prefetch_buffer_size" config:"dldataset.prefetch_buffer_size" default:"0"`
+	PrefetchOrdered    bool   `json:"prefetch_ordered" config:"dldataset.prefetch_ordered" default:"true"`
+	Offline            bool   `json:"offline" config:"dldataset.offline" default:"false"`
+	MirrorURL          string `json:"mirror_url" config:"dldataset.mirror_url" default:""`
+	// LockTimeout is how long a download waits for another process to finish downloading
+	// the same dataset. A zero duration waits indefinitely.
+	LockTimeout string `json:"lock_timeout" config:"dldataset.lock_timeout" default:"1h"`
+	// RequireChecksums fails the verification of the files that have no recorded checksum,
+	// which are otherwise only checked to exist and reported as unverified
+	RequireChecksums bool `json:"require_checksums" config:"dldataset.require_checksums" default:"false"`
+	// Datasets overrides the config of individual datasets keyed by their canonical name
+	Datasets map[string]DatasetConfig `json:"datasets" config:"-"`
+	done     chan struct{}            `json:"-" config:"-"`
+}
+
+// DatasetConfig overrides the config of a single dataset. The zero value keeps the defaults.
+type DatasetConfig struct {
+	// WorkingDirectory is the directory the files of the dataset are downloaded to, rather
+	// than <working_directory>/dldataset/<canonical name>
+	WorkingDirectory string `json:"working_directory,omitempty" mapstructure:"working_directory"`
+	// MirrorURL overrides the global mirror url for the dataset
+	MirrorURL string `json:"mirror_url,omitempty" mapstructure:"mirror_url"`
+	// Files maps the names of the files of the dataset, such as its record file, to other
+	// paths. Relative paths are relative to the working directory of the dataset.
+	Files map[string]string `json:"files,omitempty" mapstructure:"files"`
+	// Checksums replaces the recorded checksums of the files of the dataset
+	Checksums map[string]Checksum `json:"checksums,omitempty" mapstructure:"checksums"`
+	// KeepExtracted keeps the directories extracted from the downloaded archives
+	KeepExtracted bool `json:"keep_extracted,omitempty" mapstructure:"keep_extracted"`
+}
+
+// FilePath returns the path the file name is mapped to. File names are not case sensitive
+// since the config file keys are not.
+func (c DatasetConfig) FilePath(fileName string) (string, bool) {
+	for name, path := range c.Files {
+		if strings.EqualFold(name, fileName) && path != "" {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+// Checksum returns the checksum the file name is mapped to
+func (c DatasetConfig) Checksum(fileName string) (Checksum, bool) {
+	for name, checksum := range c.Checksums {
+		if strings.EqualFold(name, fileName) && !checksum.IsZero() {
+			return checksum, true
+		}
+	}
+	return Checksum{}, false
 }
 
 // Config ...
@@ -37,6 +91,30 @@ func (c *dldatasetConfig) Read() {
 	if c.WorkingDirectory == "" || c.WorkingDirectory == "default" {
 		c.WorkingDirectory = config.App.TempDir
 	}
+	c.Datasets = readDatasetConfigs()
+}
+
+// readDatasetConfigs reads the config overrides of the datasets keyed by their lower case
+// canonical name. An invalid dldataset.datasets config is logged and the defaults are kept.
+func readDatasetConfigs() map[string]DatasetConfig {
+	datasets := map[string]DatasetConfig{}
+	if err := viper.UnmarshalKey("dldataset.datasets", &datasets); err != nil {
+		// the package logger is only created once all the configs are read
+		logger.New().WithField("pkg", "dldataset").
+			WithError(err).
+			Error("failed to read the dldataset.datasets config, using the defaults")
+		return map[string]DatasetConfig{}
+	}
+	res := map[string]DatasetConfig{}
+	for name, datasetConfig := range datasets {
+		res[strings.ToLower(name)] = datasetConfig
+	}
+	return res
+}
+
+// Dataset returns the config overrides of the dataset
+func (c *dldatasetConfig) Dataset(canonicalName string) DatasetConfig {
+	return c.Datasets[strings.ToLower(canonicalName)]
 }
 
 // Wait ...
@@ -0,0 +1,57 @@
+package dldataset
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatasetConfig(t *testing.T) {
+	defer func(datasets map[string]DatasetConfig) {
+		Config.Datasets = datasets
+	}(Config.Datasets)
+
+	Config.Datasets = map[string]DatasetConfig{
+		"vision/coco2014": {
+			MirrorURL: "file:///data/mirror",
+			Files: map[string]string{
+				"coco_val.record-00000-of-00001": "/data/coco_val.record",
+			},
+			Checksums: map[string]Checksum{
+				"coco_val.record-00000-of-00001": {SHA256: "abc"},
+			},
+		},
+	}
+
+	datasetConfig := Config.Dataset("Vision/COCO2014")
+	path, ok := datasetConfig.FilePath("COCO_val.record-00000-of-00001")
+	assert.True(t, ok)
+	assert.Equal(t, "/data/coco_val.record", path)
+	checksum, ok := datasetConfig.Checksum("coco_val.record-00000-of-00001")
+	assert.True(t, ok)
+	assert.Equal(t, "abc", checksum.SHA256)
+
+	_, ok = Config.Dataset("vision/cifar10").FilePath("data_batch_1")
+	assert.False(t, ok)
+	_, ok = Config.Dataset("vision/cifar10").Checksum("data_batch_1")
+	assert.False(t, ok)
+
+	assert.Equal(t, "file:///data/mirror", Mirror("vision/coco2014"))
+}
+
+func TestReadDatasetConfigs(t *testing.T) {
+	defer viper.Set("dldataset.datasets", nil)
+
+	viper.Set("dldataset.datasets", map[string]interface{}{
+		"Vision/COCO2014": map[string]interface{}{
+			"mirror_url": "file:///data/mirror",
+		},
+	})
+	datasets := readDatasetConfigs()
+	assert.Equal(t, "file:///data/mirror", datasets["vision/coco2014"].MirrorURL)
+
+	// an invalid config keeps the defaults rather than panicking
+	viper.Set("dldataset.datasets", "invalid")
+	assert.Empty(t, readDatasetConfigs())
+}
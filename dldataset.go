@@ -14,6 +14,7 @@ type LabeledData interface {
 	Feature() *dlframework.Feature
 	Features() dlframework.Features
 	Data() (interface{}, error)
+	Metadata() Metadata
 }
 
 // Dataset ...
@@ -22,10 +23,28 @@ type Dataset interface {
 	Category() string
 	Name() string
 	CanonicalName() string
+	Info() DatasetInfo
 	Download(ctx context.Context) error
-	List(ctx context.Context) ([]string, error)
+	// Verify checks the downloaded files against their checksums, or their structure
+	// when no checksum is known, and reports the files that are missing, partial or corrupt
+	Verify(ctx context.Context) error
+	// List returns the names of the records in the order Next returns them, which is
+	// not sorted. ListSorted sorts them, and the other options filter or paginate them.
+	List(ctx context.Context, opts ...ListOption) ([]string, error)
 	Load(ctx context.Context) error
 	Get(ctx context.Context, name string) (LabeledData, error)
 	Next(ctx context.Context) (LabeledData, error)
+	// Len returns the number of records that Next iterates over
+	Len(ctx context.Context) (int, error)
+	// Reset rewinds Next to the first record
+	Reset(ctx context.Context) error
+	// Seek positions Next at the record with the given index
+	Seek(ctx context.Context, index int) error
+	// Classes returns the class vocabulary ordered by index
+	Classes(ctx context.Context) ([]Class, error)
+	// LabelIndex returns the index of the class with the given name
+	LabelIndex(ctx context.Context, name string) (int, error)
+	// LabelName returns the name of the class with the given index
+	LabelName(ctx context.Context, index int) (string, error)
 	io.Closer
 }
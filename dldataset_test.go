@@ -0,0 +1,204 @@
+package dldataset
+
+import (
+	"io"
+	"strconv"
+
+	context "context"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/dlframework"
+)
+
+type testLabeledData struct {
+	name string
+}
+
+func (l testLabeledData) Label() string {
+	return l.name
+}
+
+func (l testLabeledData) Feature() *dlframework.Feature {
+	return nil
+}
+
+func (l testLabeledData) Features() dlframework.Features {
+	return nil
+}
+
+func (l testLabeledData) Data() (interface{}, error) {
+	return l.name, nil
+}
+
+func (l testLabeledData) Metadata() Metadata {
+	return Metadata{ID: l.name}
+}
+
+// testDataset is an in-memory dataset whose elements are named "0", "1", ...
+type testDataset struct {
+	name   string
+	names  []string
+	cursor int
+}
+
+func newTestDataset(n int) *testDataset {
+	names := make([]string, n)
+	for ii := range names {
+		names[ii] = strconv.Itoa(ii)
+	}
+	return &testDataset{names: names}
+}
+
+func newNamedTestDataset(name string, n int) *testDataset {
+	d := newTestDataset(n)
+	d.name = name
+	return d
+}
+
+func (d *testDataset) New(ctx context.Context) (Dataset, error) {
+	return &testDataset{name: d.name, names: d.names}, nil
+}
+
+func (d *testDataset) Category() string {
+	return "test"
+}
+
+func (d *testDataset) Name() string {
+	if d.name == "" {
+		return "dataset"
+	}
+	return d.name
+}
+
+func (d *testDataset) CanonicalName() string {
+	return "test/" + d.Name()
+}
+
+func (d *testDataset) Info() DatasetInfo {
+	return DatasetInfo{
+		CanonicalName: d.CanonicalName(),
+		Category:      d.Category(),
+		Name:          d.Name(),
+		Task:          ClassificationTask,
+		Splits:        map[string]int{"test": len(d.names)},
+	}
+}
+
+func (d *testDataset) Download(ctx context.Context) error {
+	return nil
+}
+
+func (d *testDataset) Verify(ctx context.Context) error {
+	return nil
+}
+
+func (d *testDataset) List(ctx context.Context, opts ...ListOption) ([]string, error) {
+	return ApplyListOptions(d.names, opts...)
+}
+
+func (d *testDataset) Load(ctx context.Context) error {
+	return nil
+}
+
+func (d *testDataset) Get(ctx context.Context, name string) (LabeledData, error) {
+	for _, n := range d.names {
+		if n == name {
+			return testLabeledData{name: name}, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrNotFound, "unable to find %s", name)
+}
+
+func (d *testDataset) Next(ctx context.Context) (LabeledData, error) {
+	if d.cursor >= len(d.names) {
+		return nil, io.EOF
+	}
+	d.cursor++
+	return testLabeledData{name: d.names[d.cursor-1]}, nil
+}
+
+func (d *testDataset) Len(ctx context.Context) (int, error) {
+	return len(d.names), nil
+}
+
+func (d *testDataset) Reset(ctx context.Context) error {
+	d.cursor = 0
+	return nil
+}
+
+func (d *testDataset) Seek(ctx context.Context, index int) error {
+	if index < 0 || index > len(d.names) {
+		return errors.Errorf("the index %d is out of range %d", index, len(d.names))
+	}
+	d.cursor = index
+	return nil
+}
+
+func (d *testDataset) Classes(ctx context.Context) ([]Class, error) {
+	return d.classMap().Classes(), nil
+}
+
+func (d *testDataset) LabelIndex(ctx context.Context, name string) (int, error) {
+	return d.classMap().LabelIndex(name)
+}
+
+func (d *testDataset) LabelName(ctx context.Context, index int) (string, error) {
+	return d.classMap().LabelName(index)
+}
+
+// classMap has a class for every record, named after the record
+func (d *testDataset) classMap() *ClassMap {
+	classes := make([]Class, len(d.names))
+	for ii, name := range d.names {
+		classes[ii] = Class{Index: ii, Name: name}
+	}
+	return NewClassMap(classes)
+}
+
+func (d *testDataset) Close() error {
+	return nil
+}
+
+func labels(data []LabeledData) []string {
+	res := make([]string, len(data))
+	for ii, elem := range data {
+		res[ii] = elem.Label()
+	}
+	return res
+}
+
+// testStreamDataset is a dataset that can only be read with Next
+type testStreamDataset struct {
+	*testDataset
+}
+
+func (d testStreamDataset) List(ctx context.Context, opts ...ListOption) ([]string, error) {
+	return nil, errors.Wrap(ErrNotSupported, "list is not implemented")
+}
+
+func (d testStreamDataset) Get(ctx context.Context, name string) (LabeledData, error) {
+	return nil, errors.Wrap(ErrNotSupported, "get is not implemented")
+}
+
+// testListErrorDataset is a dataset whose List fails for a reason other than not supporting it
+type testListErrorDataset struct {
+	*testDataset
+}
+
+func (d testListErrorDataset) List(ctx context.Context, opts ...ListOption) ([]string, error) {
+	return nil, errors.Wrap(ErrNotDownloaded, "the index is missing")
+}
+
+func readAll(ctx context.Context, dataset Dataset) ([]string, error) {
+	res := []string{}
+	for {
+		data, err := dataset.Next(ctx)
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, data.Label())
+	}
+}
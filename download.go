@@ -0,0 +1,143 @@
+package dldataset
+
+import (
+	"sort"
+	"time"
+
+	context "context"
+
+	"github.com/pkg/errors"
+)
+
+// DatasetFiles describes the files of a dataset that are downloaded to its working directory
+type DatasetFiles struct {
+	// Dataset is the canonical name of the dataset
+	Dataset    string
+	WorkingDir string
+	// Paths maps the names of the files to where they are stored, which can be outside of
+	// the working directory
+	Paths map[string]string
+	// URLs maps the names of the files to the urls they were downloaded or extracted from
+	URLs map[string]string
+}
+
+// Manifest reads the manifest of the working directory if it records the files at their
+// current paths. It returns nil if there is no manifest or if the manifest records other
+// files, in which case the files have to be verified against their published checksums.
+// A manifest that cannot be read or decoded is an error, so that the files are not trusted.
+func (f DatasetFiles) Manifest() (*Manifest, error) {
+	manifest, err := ReadManifest(f.WorkingDir)
+	if IsNotDownloaded(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Dataset != f.Dataset || len(manifest.Files) != len(f.Paths) {
+		return nil, nil
+	}
+	for _, file := range manifest.Files {
+		if path, ok := f.Paths[file.Name]; !ok || path != file.Path {
+			return nil, nil
+		}
+	}
+	return manifest, nil
+}
+
+// ManifestMatches reports whether the files have the sizes and the modification times
+// recorded in the manifest, in which case they are trusted without computing their checksums
+func (f DatasetFiles) ManifestMatches() bool {
+	manifest, err := f.Manifest()
+	if err != nil || manifest == nil {
+		return false
+	}
+	return manifest.Check() == nil
+}
+
+// VerifyManifest verifies the files against the checksums recorded in the manifest. It
+// returns false if there is no manifest of the files, in which case they are not verified.
+func (f DatasetFiles) VerifyManifest() (bool, error) {
+	manifest, err := f.Manifest()
+	if err != nil || manifest == nil {
+		return false, err
+	}
+	return true, manifest.Verify()
+}
+
+// DiskSize returns the number of bytes of the files recorded in the manifest, or zero if
+// the dataset was not downloaded
+func (f DatasetFiles) DiskSize() int64 {
+	manifest, err := f.Manifest()
+	if err != nil || manifest == nil {
+		return 0
+	}
+	var size int64
+	for _, file := range manifest.Files {
+		size += file.Size
+	}
+	return size
+}
+
+// WriteManifest records the files in the manifest of the working directory
+func (f DatasetFiles) WriteManifest() error {
+	fileNames := make([]string, 0, len(f.Paths))
+	for fileName := range f.Paths {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	manifest := &Manifest{
+		Dataset:      f.Dataset,
+		DownloadedAt: time.Now().UTC(),
+		Files:        make([]ManifestFile, 0, len(fileNames)),
+	}
+	for _, fileName := range fileNames {
+		file, err := NewManifestFile(fileName, f.Paths[fileName], f.URLs[fileName])
+		if err != nil {
+			return err
+		}
+		manifest.Files = append(manifest.Files, file)
+	}
+	return WriteManifest(f.WorkingDir, manifest)
+}
+
+// DownloadFunc fetches the files of a dataset after they failed the verification with verifyErr
+type DownloadFunc func(ctx context.Context, verifyErr error) error
+
+// DownloadFiles downloads the files of a dataset unless a previous download can be used.
+// It holds the lock of the working directory, so that a single process at a time downloads
+// the files. The files of a previous download are trusted if they did not change since the
+// manifest was written, and are otherwise only used if verify accepts them. If they are not
+// intact then the manifest is removed and download is called, after which the files are
+// verified again and recorded in a new manifest.
+func DownloadFiles(ctx context.Context, files DatasetFiles, verify func(ctx context.Context) error, download DownloadFunc) error {
+	unlock, err := LockWorkingDir(ctx, files.WorkingDir, LockTimeout())
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if files.ManifestMatches() {
+		return nil
+	}
+	verifyErr := verify(ctx)
+	if verifyErr == nil {
+		return files.WriteManifest()
+	}
+	if IsUnverified(verifyErr) {
+		// downloading the files again would not record their checksums
+		return verifyErr
+	}
+	if !CanDownload(files.Dataset) {
+		return errors.Wrapf(ErrOffline, "the files of the %s dataset need to be staged in the working directory or a local mirror in offline mode: %v", files.Dataset, verifyErr)
+	}
+	if err := RemoveManifest(files.WorkingDir); err != nil {
+		return err
+	}
+	if err := download(ctx, verifyErr); err != nil {
+		return err
+	}
+	if err := verify(ctx); err != nil {
+		return err
+	}
+	return files.WriteManifest()
+}
@@ -0,0 +1,161 @@
+package dldataset
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	context "context"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatasetFilesManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	recordFileName := filepath.Join(dir, "coco_val.record")
+	files := DatasetFiles{
+		Dataset:    "vision/coco2014",
+		WorkingDir: dir,
+		Paths:      map[string]string{"coco_val.record": recordFileName},
+		URLs:       map[string]string{"coco_val.record": "https://s3.amazonaws.com/store.carml.org/datasets/coco2014/coco_val.record"},
+	}
+	assert.False(t, files.ManifestMatches())
+	assert.Zero(t, files.DiskSize())
+	ok, err := files.VerifyManifest()
+	assert.False(t, ok)
+	assert.NoError(t, err)
+
+	assert.NoError(t, ioutil.WriteFile(recordFileName, []byte("record"), 0644))
+	assert.NoError(t, files.WriteManifest())
+	manifest, err := ReadManifest(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, "vision/coco2014", manifest.Dataset)
+	if assert.Len(t, manifest.Files, 1) {
+		assert.Equal(t, recordFileName, manifest.Files[0].Path)
+		assert.Equal(t, files.URLs["coco_val.record"], manifest.Files[0].URL)
+		assert.Equal(t, int64(6), manifest.Files[0].Size)
+	}
+	assert.Equal(t, int64(6), files.DiskSize())
+	assert.True(t, files.ManifestMatches())
+	ok, err = files.VerifyManifest()
+	assert.True(t, ok)
+	assert.NoError(t, err)
+
+	// a manifest of other files is not used
+	otherFiles := files
+	otherFiles.Paths = map[string]string{"pascal_val.record": filepath.Join(dir, "pascal_val.record")}
+	assert.False(t, otherFiles.ManifestMatches())
+	ok, err = otherFiles.VerifyManifest()
+	assert.False(t, ok)
+	assert.NoError(t, err)
+
+	// a file that was modified without changing its size and modification time
+	// is trusted by Download but not by Verify
+	modTime := manifest.Files[0].ModTime
+	assert.NoError(t, ioutil.WriteFile(recordFileName, []byte("RECORD"), 0644))
+	assert.NoError(t, os.Chtimes(recordFileName, modTime, modTime))
+	assert.True(t, files.ManifestMatches())
+	ok, err = files.VerifyManifest()
+	assert.True(t, ok)
+	assert.True(t, IsChecksumMismatch(err))
+
+	// a truncated file is detected without computing its checksum
+	assert.NoError(t, ioutil.WriteFile(recordFileName, []byte("rec"), 0644))
+	assert.False(t, files.ManifestMatches())
+
+	// a corrupt manifest invalidates the files instead of being ignored
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, ManifestFileName), []byte("{"), 0644))
+	assert.False(t, files.ManifestMatches())
+	_, err = files.VerifyManifest()
+	assert.True(t, IsCorruptRecord(err))
+}
+
+func TestDownloadFiles(t *testing.T) {
+	ctx := context.Background()
+	defer func(offline bool, mirror string) {
+		Config.Offline, Config.MirrorURL = offline, mirror
+	}(Config.Offline, Config.MirrorURL)
+	Config.Offline, Config.MirrorURL = false, ""
+
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	recordFileName := filepath.Join(dir, "record")
+	files := DatasetFiles{
+		Dataset:    "test/dataset",
+		WorkingDir: dir,
+		Paths:      map[string]string{"record": recordFileName},
+	}
+	verifies, downloads := 0, 0
+	verify := func(ctx context.Context) error {
+		verifies++
+		if ok, err := files.VerifyManifest(); ok || err != nil {
+			return err
+		}
+		return VerifyFile(recordFileName, Checksum{})
+	}
+	download := func(ctx context.Context, verifyErr error) error {
+		downloads++
+		assert.Error(t, verifyErr)
+		return ioutil.WriteFile(recordFileName, []byte("record"), 0644)
+	}
+
+	assert.NoError(t, DownloadFiles(ctx, files, verify, download))
+	assert.Equal(t, 2, verifies)
+	assert.Equal(t, 1, downloads)
+	assert.True(t, files.ManifestMatches())
+
+	// the files of the previous download are trusted without verifying them
+	assert.NoError(t, DownloadFiles(ctx, files, verify, download))
+	assert.Equal(t, 2, verifies)
+	assert.Equal(t, 1, downloads)
+
+	// a corrupt manifest causes the files to be downloaded again
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, ManifestFileName), []byte("{"), 0644))
+	assert.NoError(t, DownloadFiles(ctx, files, verify, download))
+	assert.Equal(t, 4, verifies)
+	assert.Equal(t, 2, downloads)
+	assert.True(t, files.ManifestMatches())
+
+	// a download error is returned without writing the manifest
+	assert.NoError(t, os.Remove(recordFileName))
+	err = DownloadFiles(ctx, files, verify, func(ctx context.Context, verifyErr error) error {
+		return errors.New("connection reset")
+	})
+	assert.EqualError(t, err, "connection reset")
+	_, err = ReadManifest(dir)
+	assert.True(t, IsNotDownloaded(err))
+
+	// the files are not downloaded in offline mode
+	Config.Offline = true
+	err = DownloadFiles(ctx, files, verify, download)
+	assert.True(t, IsOffline(err))
+	assert.Equal(t, 2, downloads)
+}
+
+func TestDownloadFilesUnverified(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	files := DatasetFiles{
+		Dataset:    "test/dataset",
+		WorkingDir: dir,
+		Paths:      map[string]string{"record": filepath.Join(dir, "record")},
+	}
+	err = DownloadFiles(context.Background(), files, func(ctx context.Context) error {
+		return errors.Wrap(ErrUnverified, "no checksum is recorded for record")
+	}, func(ctx context.Context, verifyErr error) error {
+		t.Fatal("the files are downloaded again although that does not record their checksums")
+		return nil
+	})
+	assert.True(t, IsUnverified(err))
+	_, err = ReadManifest(dir)
+	assert.True(t, IsNotDownloaded(err))
+}
@@ -0,0 +1,66 @@
+package dldataset
+
+import (
+	"github.com/pkg/errors"
+)
+
+// The errors returned by the registry, the datasets and the readers wrap one of these
+// errors so that callers can tell the failure modes apart with errors.Cause
+var (
+	// ErrNotFound is returned when a dataset, a record or a class does not exist
+	ErrNotFound = errors.New("not found")
+	// ErrNotDownloaded is returned when the files of a dataset are missing from the working directory
+	ErrNotDownloaded = errors.New("the dataset has not been downloaded")
+	// ErrNotSupported is returned when a dataset or a file does not support an operation
+	ErrNotSupported = errors.New("not supported")
+	// ErrCorruptRecord is returned when a record or an index cannot be decoded
+	ErrCorruptRecord = errors.New("corrupt record")
+	// ErrChecksumMismatch is returned when a downloaded file does not match its checksum
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	// ErrOffline is returned when a file has to be downloaded but the offline mode is set
+	ErrOffline = errors.New("offline mode")
+	// ErrLockTimeout is returned when another process holds the lock of a working directory for too long
+	ErrLockTimeout = errors.New("lock timeout")
+	// ErrUnverified is returned when a file has no recorded checksum and checksums are required
+	ErrUnverified = errors.New("no recorded checksum")
+)
+
+// IsNotFound ...
+func IsNotFound(err error) bool {
+	return err != nil && errors.Cause(err) == ErrNotFound
+}
+
+// IsNotDownloaded ...
+func IsNotDownloaded(err error) bool {
+	return err != nil && errors.Cause(err) == ErrNotDownloaded
+}
+
+// IsNotSupported ...
+func IsNotSupported(err error) bool {
+	return err != nil && errors.Cause(err) == ErrNotSupported
+}
+
+// IsCorruptRecord ...
+func IsCorruptRecord(err error) bool {
+	return err != nil && errors.Cause(err) == ErrCorruptRecord
+}
+
+// IsChecksumMismatch ...
+func IsChecksumMismatch(err error) bool {
+	return err != nil && errors.Cause(err) == ErrChecksumMismatch
+}
+
+// IsOffline ...
+func IsOffline(err error) bool {
+	return err != nil && errors.Cause(err) == ErrOffline
+}
+
+// IsLockTimeout ...
+func IsLockTimeout(err error) bool {
+	return err != nil && errors.Cause(err) == ErrLockTimeout
+}
+
+// IsUnverified ...
+func IsUnverified(err error) bool {
+	return err != nil && errors.Cause(err) == ErrUnverified
+}
@@ -0,0 +1,32 @@
+package dldataset
+
+import (
+	"testing"
+
+	context "context"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrors(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := Take(newTestDataset(10), 3).Get(ctx, "5")
+	assert.True(t, IsNotFound(err))
+	assert.False(t, IsCorruptRecord(err))
+
+	_, err = ApplyListOptions([]string{"a", "b"}, ListAfter("c"))
+	assert.True(t, IsNotFound(err))
+
+	_, err = Repeat(newTestDataset(10), 0).Len(ctx)
+	assert.True(t, IsNotSupported(err))
+
+	err = errors.Wrap(errors.Wrap(ErrCorruptRecord, "invalid magic number"), "cannot read record")
+	assert.True(t, IsCorruptRecord(err))
+	assert.Equal(t, ErrCorruptRecord, errors.Cause(err))
+
+	assert.False(t, IsNotFound(nil))
+	assert.False(t, IsChecksumMismatch(errors.New("checksum mismatch")))
+	assert.True(t, IsUnverified(errors.Wrap(ErrUnverified, "no checksum is recorded")))
+}
@@ -0,0 +1,41 @@
+package dldataset
+
+// TaskType is the kind of task a dataset is labeled for
+type TaskType string
+
+const (
+	// ClassificationTask datasets label each image with a class
+	ClassificationTask TaskType = "classification"
+	// DetectionTask datasets label each image with bounding boxes
+	DetectionTask TaskType = "detection"
+	// SegmentationTask datasets label each pixel of an image
+	SegmentationTask TaskType = "segmentation"
+)
+
+// DatasetInfo describes a dataset. Fields that are not known are left as their zero value.
+type DatasetInfo struct {
+	CanonicalName string         `json:"canonical_name"`
+	Category      string         `json:"category"`
+	Name          string         `json:"name"`
+	Task          TaskType       `json:"task"`
+	Splits        map[string]int `json:"splits,omitempty"`
+	NumClasses    int            `json:"num_classes,omitempty"`
+	// ImageSize is the width, height and number of channels of the images when
+	// all the images have the same size
+	ImageSize     []int    `json:"image_size,omitempty"`
+	Preprocessing []string `json:"preprocessing,omitempty"`
+	// DiskSize is the approximate number of bytes of the downloaded files
+	DiskSize int64    `json:"disk_size,omitempty"`
+	URLs     []string `json:"urls,omitempty"`
+	License  string   `json:"license,omitempty"`
+	Citation string   `json:"citation,omitempty"`
+}
+
+// NumExamples returns the number of examples across all the splits
+func (i DatasetInfo) NumExamples() int {
+	n := 0
+	for _, count := range i.Splits {
+		n += count
+	}
+	return n
+}
@@ -0,0 +1,27 @@
+package dldataset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDatasetInfo(t *testing.T) {
+	Register(newTestDataset(10))
+
+	info, err := GetInfo("Test", "Dataset")
+	assert.NoError(t, err)
+	assert.Equal(t, "test/dataset", info.CanonicalName)
+	assert.Equal(t, ClassificationTask, info.Task)
+	assert.Equal(t, 10, info.NumExamples())
+
+	// other tests can register datasets too, so only the test dataset is looked for
+	found := false
+	for _, other := range DatasetInfos() {
+		if other.CanonicalName == info.CanonicalName {
+			assert.Equal(t, info, other)
+			found = true
+		}
+	}
+	assert.True(t, found)
+}
@@ -0,0 +1,144 @@
+package dldataset
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ListOptions ...
+type ListOptions struct {
+	// Sorted sorts the names, otherwise they are in the order Next returns the records
+	Sorted bool
+	// Prefix keeps the names that start with the prefix
+	Prefix string
+	// Pattern keeps the names that match the path.Match pattern
+	Pattern string
+	// After keeps the names that come after the given name. It is used as a
+	// cursor by passing the last name of the previous page.
+	After string
+	// Offset skips the first names
+	Offset int
+	// Limit is the maximum number of names returned. It is ignored if it is not positive.
+	Limit int
+}
+
+// ListOption ...
+type ListOption func(*ListOptions)
+
+// NewListOptions ...
+func NewListOptions(opts ...ListOption) ListOptions {
+	options := ListOptions{}
+	for _, o := range opts {
+		o(&options)
+	}
+	return options
+}
+
+// ListSorted sorts the names, which are otherwise in the order Next returns the records
+func ListSorted() ListOption {
+	return func(o *ListOptions) {
+		o.Sorted = true
+	}
+}
+
+// ListPrefix ...
+func ListPrefix(prefix string) ListOption {
+	return func(o *ListOptions) {
+		o.Prefix = prefix
+	}
+}
+
+// ListGlob keeps the names that match the pattern, for example train/* or n01440764/*
+func ListGlob(pattern string) ListOption {
+	return func(o *ListOptions) {
+		o.Pattern = pattern
+	}
+}
+
+// ListAfter ...
+func ListAfter(name string) ListOption {
+	return func(o *ListOptions) {
+		o.After = name
+	}
+}
+
+// ListOffset ...
+func ListOffset(offset int) ListOption {
+	return func(o *ListOptions) {
+		o.Offset = offset
+	}
+}
+
+// ListLimit ...
+func ListLimit(limit int) ListOption {
+	return func(o *ListOptions) {
+		o.Limit = limit
+	}
+}
+
+// ApplyListOptions sorts, filters and paginates the names returned by a dataset's List.
+// The names are filtered first, then the page starts after the After name and the Offset,
+// and has at most Limit names. The names slice is not modified, and the result is always
+// a copy, so callers can modify it without changing the names cached by the dataset.
+func ApplyListOptions(names []string, opts ...ListOption) ([]string, error) {
+	if len(opts) == 0 {
+		return append([]string{}, names...), nil
+	}
+	options := NewListOptions(opts...)
+	if options.Offset < 0 {
+		return nil, errors.Errorf("the list offset %d must not be negative", options.Offset)
+	}
+	if options.Pattern != "" {
+		if _, err := path.Match(options.Pattern, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid list pattern %s", options.Pattern)
+		}
+	}
+
+	res := make([]string, 0, len(names))
+	for _, name := range names {
+		if options.Prefix != "" && !strings.HasPrefix(name, options.Prefix) {
+			continue
+		}
+		if options.Pattern != "" {
+			if ok, _ := path.Match(options.Pattern, name); !ok {
+				continue
+			}
+		}
+		res = append(res, name)
+	}
+	if options.Sorted {
+		sort.Strings(res)
+	}
+
+	if options.After != "" {
+		start := -1
+		if options.Sorted {
+			start = sort.Search(len(res), func(ii int) bool {
+				return res[ii] > options.After
+			})
+		} else {
+			for ii, name := range res {
+				if name == options.After {
+					start = ii + 1
+					break
+				}
+			}
+		}
+		if start == -1 {
+			return nil, errors.Wrapf(ErrNotFound, "unable to find the list cursor %s", options.After)
+		}
+		res = res[start:]
+	}
+
+	if options.Offset >= len(res) {
+		return []string{}, nil
+	}
+	res = res[options.Offset:]
+	if options.Limit > 0 && options.Limit < len(res) {
+		res = res[:options.Limit]
+	}
+	return res, nil
+}
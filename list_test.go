@@ -0,0 +1,77 @@
+package dldataset
+
+import (
+	"testing"
+
+	context "context"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	ds := newTestDataset(12)
+
+	names, err := ds.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "10", names[10])
+
+	names, err = ds.List(ctx, ListSorted())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0", "1", "10", "11", "2"}, names[:5])
+
+	names, err = ds.List(ctx, ListPrefix("1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "10", "11"}, names)
+
+	names, err = ds.List(ctx, ListGlob("1?"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10", "11"}, names)
+
+	_, err = ds.List(ctx, ListGlob("["))
+	assert.Error(t, err)
+
+	names, err = ds.List(ctx, ListOffset(3), ListLimit(2))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3", "4"}, names)
+
+	names, err = ds.List(ctx, ListOffset(20))
+	assert.NoError(t, err)
+	assert.Empty(t, names)
+
+	pages := []string{}
+	after := ""
+	for {
+		page, err := ds.List(ctx, ListSorted(), ListAfter(after), ListLimit(5))
+		assert.NoError(t, err)
+		if len(page) == 0 {
+			break
+		}
+		pages = append(pages, page...)
+		after = page[len(page)-1]
+	}
+	sorted, err := ds.List(ctx, ListSorted())
+	assert.NoError(t, err)
+	assert.Equal(t, sorted, pages)
+
+	names, err = ds.List(ctx, ListAfter("9"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10", "11"}, names)
+
+	_, err = ds.List(ctx, ListAfter("missing"))
+	assert.Error(t, err)
+}
+
+func TestApplyListOptionsCopy(t *testing.T) {
+	names := []string{"b", "a"}
+
+	res, err := ApplyListOptions(names)
+	assert.NoError(t, err)
+	res[0] = "c"
+	assert.Equal(t, []string{"b", "a"}, names)
+
+	res, err = ApplyListOptions(names, ListSorted())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, res)
+	assert.Equal(t, []string{"b", "a"}, names)
+}
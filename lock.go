@@ -0,0 +1,64 @@
+package dldataset
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	context "context"
+
+	"github.com/gofrs/flock"
+	"github.com/pkg/errors"
+)
+
+// LockFileName is the name of the lock file in the working directory of a dataset
+const LockFileName = ".dldataset.lock"
+
+// lockRetryDelay is how often a waiting process tries to take the lock again
+var lockRetryDelay = 500 * time.Millisecond
+
+// LockTimeout returns the lock timeout of the config, or zero to wait indefinitely
+func LockTimeout() time.Duration {
+	if Config.LockTimeout == "" {
+		return 0
+	}
+	timeout, err := time.ParseDuration(Config.LockTimeout)
+	if err != nil || timeout < 0 {
+		log.WithField("lock_timeout", Config.LockTimeout).Warn("invalid lock timeout, waiting indefinitely")
+		return 0
+	}
+	return timeout
+}
+
+// LockWorkingDir takes an exclusive advisory lock on the working directory of a dataset, so
+// that a single process at a time downloads or extracts the files of the dataset. It waits
+// until the lock is released, the context is done or the lock timeout has passed, in which
+// case the error wraps ErrLockTimeout. The returned function releases the lock.
+func LockWorkingDir(ctx context.Context, workingDir string, timeout time.Duration) (func() error, error) {
+	if err := os.MkdirAll(workingDir, os.ModePerm); err != nil {
+		return nil, errors.Wrapf(err, "cannot create the directory %v", workingDir)
+	}
+	path := filepath.Join(workingDir, LockFileName)
+	lock := flock.New(path)
+
+	lockCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		lockCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	locked, err := lock.TryLockContext(lockCtx, lockRetryDelay)
+	if err != nil && ctx.Err() != nil {
+		return nil, errors.Wrapf(ctx.Err(), "stopped waiting for the lock %v", path)
+	}
+	if err == context.DeadlineExceeded {
+		return nil, errors.Wrapf(ErrLockTimeout, "another process held the lock %v for more than %v", path, timeout)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot take the lock %v", path)
+	}
+	if !locked {
+		return nil, errors.Wrapf(ErrLockTimeout, "cannot take the lock %v", path)
+	}
+	return lock.Unlock, nil
+}
@@ -0,0 +1,63 @@
+package dldataset
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	context "context"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockWorkingDir(t *testing.T) {
+	ctx := context.Background()
+	defer func(delay time.Duration) {
+		lockRetryDelay = delay
+	}(lockRetryDelay)
+	lockRetryDelay = 10 * time.Millisecond
+
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	workingDir := filepath.Join(dir, "vision", "cifar10")
+	unlock, err := LockWorkingDir(ctx, workingDir, 0)
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(workingDir, LockFileName))
+	assert.NoError(t, err)
+
+	_, err = LockWorkingDir(ctx, workingDir, 50*time.Millisecond)
+	assert.True(t, IsLockTimeout(err))
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = LockWorkingDir(cancelledCtx, workingDir, 0)
+	assert.Error(t, err)
+	assert.False(t, IsLockTimeout(err))
+
+	firstUnlock := unlock
+	released := make(chan struct{})
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		assert.NoError(t, firstUnlock())
+		close(released)
+	}()
+	secondUnlock, err := LockWorkingDir(ctx, workingDir, time.Minute)
+	assert.NoError(t, err)
+	<-released
+	assert.NoError(t, secondUnlock())
+}
+
+func TestLockTimeout(t *testing.T) {
+	defer func(timeout string) {
+		Config.LockTimeout = timeout
+	}(Config.LockTimeout)
+
+	Config.LockTimeout = "90s"
+	assert.Equal(t, 90*time.Second, LockTimeout())
+	Config.LockTimeout = ""
+	assert.Equal(t, time.Duration(0), LockTimeout())
+}
@@ -0,0 +1,162 @@
+package dldataset
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ManifestFileName is the name of the manifest that Download writes to the working directory of a dataset
+const ManifestFileName = "dldataset_manifest.json"
+
+// ManifestFile describes a file of a dataset when it was downloaded
+type ManifestFile struct {
+	// Name is the name of the file within the dataset
+	Name string `json:"name"`
+	// Path is where the file is stored, which can be outside of the working directory
+	Path string `json:"path"`
+	URL  string `json:"url,omitempty"`
+	Size int64  `json:"size"`
+	// ModTime is the modification time of the file when the manifest was written
+	ModTime  time.Time `json:"mod_time"`
+	Checksum Checksum  `json:"checksum"`
+}
+
+// Manifest records the files of a dataset after they were downloaded and verified,
+// so that later processes can trust them without downloading them again
+type Manifest struct {
+	Dataset      string         `json:"dataset"`
+	DownloadedAt time.Time      `json:"downloaded_at"`
+	Files        []ManifestFile `json:"files"`
+}
+
+// NewManifestFile computes the size and the checksum of the file
+func NewManifestFile(name, path, url string) (ManifestFile, error) {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return ManifestFile{}, errors.Wrapf(ErrNotDownloaded, "the file %v does not exist", path)
+	}
+	if err != nil {
+		return ManifestFile{}, errors.Wrapf(err, "cannot stat %v", path)
+	}
+	checksum, err := FileChecksum(path)
+	if err != nil {
+		return ManifestFile{}, err
+	}
+	return ManifestFile{
+		Name:     name,
+		Path:     path,
+		URL:      url,
+		Size:     info.Size(),
+		ModTime:  info.ModTime().UTC(),
+		Checksum: checksum,
+	}, nil
+}
+
+// Check checks that the files exist with the size and the modification time recorded in
+// the manifest, in which case the files can be trusted without computing their checksums.
+// It is cheap enough to run every time a dataset is downloaded.
+func (m *Manifest) Check() error {
+	for _, file := range m.Files {
+		info, err := m.stat(file)
+		if err != nil {
+			return err
+		}
+		if !info.ModTime().Equal(file.ModTime) {
+			return errors.Wrapf(ErrChecksumMismatch, "the file %v of %v was modified after the manifest was written", file.Path, m.Dataset)
+		}
+	}
+	return nil
+}
+
+// Verify checks the files against the sizes and the checksums recorded in the manifest.
+// Unlike Check it does not depend on the modification times.
+func (m *Manifest) Verify() error {
+	for _, file := range m.Files {
+		if _, err := m.stat(file); err != nil {
+			return err
+		}
+	}
+	for _, file := range m.Files {
+		if err := VerifyFile(file.Path, file.Checksum); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manifest) stat(file ManifestFile) (os.FileInfo, error) {
+	info, err := os.Stat(file.Path)
+	if os.IsNotExist(err) {
+		return nil, errors.Wrapf(ErrNotDownloaded, "the file %v of %v does not exist", file.Path, m.Dataset)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot stat %v", file.Path)
+	}
+	if info.Size() != file.Size {
+		return nil, errors.Wrapf(ErrChecksumMismatch, "the size %v of %v does not match the size %v in the manifest of %v", info.Size(), file.Path, file.Size, m.Dataset)
+	}
+	return info, nil
+}
+
+// ReadManifest reads the manifest from the working directory of a dataset.
+// The error wraps ErrNotDownloaded if the directory has no manifest.
+func ReadManifest(workingDir string) (*Manifest, error) {
+	path := filepath.Join(workingDir, ManifestFileName)
+	bts, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, errors.Wrapf(ErrNotDownloaded, "the manifest %v does not exist", path)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read the manifest %v", path)
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(bts, manifest); err != nil {
+		return nil, errors.Wrapf(ErrCorruptRecord, "cannot decode the manifest %v: %v", path, err)
+	}
+	return manifest, nil
+}
+
+// WriteManifest writes the manifest to the working directory of a dataset. The manifest
+// is written to a temporary file that is renamed, so readers never see a partial manifest.
+func WriteManifest(workingDir string, manifest *Manifest) error {
+	bts, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "cannot encode the manifest of %v", manifest.Dataset)
+	}
+	if err := os.MkdirAll(workingDir, os.ModePerm); err != nil {
+		return errors.Wrapf(err, "cannot create the directory %v", workingDir)
+	}
+	path := filepath.Join(workingDir, ManifestFileName)
+	f, err := ioutil.TempFile(workingDir, ManifestFileName+".")
+	if err != nil {
+		return errors.Wrapf(err, "cannot create the manifest %v", path)
+	}
+	if _, err := f.Write(bts); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return errors.Wrapf(err, "cannot write the manifest %v", path)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return errors.Wrapf(err, "cannot write the manifest %v", path)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		os.Remove(f.Name())
+		return errors.Wrapf(err, "cannot rename the manifest %v", path)
+	}
+	return nil
+}
+
+// RemoveManifest invalidates the download of a dataset by removing its manifest
+func RemoveManifest(workingDir string) error {
+	path := filepath.Join(workingDir, ManifestFileName)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "cannot remove the manifest %v", path)
+	}
+	return nil
+}
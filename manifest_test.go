@@ -0,0 +1,63 @@
+package dldataset
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = ReadManifest(dir)
+	assert.True(t, IsNotDownloaded(err))
+
+	path := filepath.Join(dir, "data.bin")
+	assert.NoError(t, ioutil.WriteFile(path, []byte("hello world"), 0644))
+	file, err := NewManifestFile("data.bin", path, "http://mirror.local/data.bin")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), file.Size)
+	assert.Equal(t, "5eb63bbbe01eeed093cb22bb8f5acdc3", file.Checksum.MD5)
+
+	downloadedAt := time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, WriteManifest(dir, &Manifest{
+		Dataset:      "vision/test",
+		DownloadedAt: downloadedAt,
+		Files:        []ManifestFile{file},
+	}))
+	manifest, err := ReadManifest(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, "vision/test", manifest.Dataset)
+	assert.True(t, downloadedAt.Equal(manifest.DownloadedAt))
+	assert.Equal(t, []ManifestFile{file}, manifest.Files)
+	assert.NoError(t, manifest.Check())
+	assert.NoError(t, manifest.Verify())
+
+	// a file that was touched is only trusted again after the full verification
+	modTime := file.ModTime.Add(time.Minute)
+	assert.NoError(t, os.Chtimes(path, modTime, modTime))
+	assert.True(t, IsChecksumMismatch(manifest.Check()))
+	assert.NoError(t, manifest.Verify())
+
+	// a file of the same size and modification time with other content only fails the full verification
+	assert.NoError(t, ioutil.WriteFile(path, []byte("hello there"), 0644))
+	assert.NoError(t, os.Chtimes(path, file.ModTime, file.ModTime))
+	assert.NoError(t, manifest.Check())
+	assert.True(t, IsChecksumMismatch(manifest.Verify()))
+
+	assert.NoError(t, ioutil.WriteFile(path, []byte("hello"), 0644))
+	assert.True(t, IsChecksumMismatch(manifest.Check()))
+	assert.NoError(t, os.Remove(path))
+	assert.True(t, IsNotDownloaded(manifest.Check()))
+
+	assert.NoError(t, RemoveManifest(dir))
+	assert.NoError(t, RemoveManifest(dir))
+	_, err = ReadManifest(dir)
+	assert.True(t, IsNotDownloaded(err))
+}
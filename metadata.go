@@ -0,0 +1,15 @@
+package dldataset
+
+// Metadata identifies the source of a record so that results can be joined back to it.
+// Fields that are not known are left as their zero value.
+type Metadata struct {
+	// ID is the name of the record as returned by the dataset's List
+	ID string `json:"id"`
+	// SourceID is the identifier of the record in the original dataset, for example
+	// the COCO image id or the RecordIO record id
+	SourceID string `json:"source_id,omitempty"`
+	FileName string `json:"file_name,omitempty"`
+	Width    int    `json:"width,omitempty"`
+	Height   int    `json:"height,omitempty"`
+	SHA256   string `json:"sha256,omitempty"`
+}
@@ -0,0 +1,54 @@
+package dldataset
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Mirror returns the base url of the mirror the files of the dataset are downloaded from,
+// or an empty string if the files are downloaded from their original urls
+func Mirror(canonicalName string) string {
+	if mirror := Config.Dataset(canonicalName).MirrorURL; mirror != "" {
+		return mirror
+	}
+	return Config.MirrorURL
+}
+
+// MirrorURL returns the url to download a file of the dataset from. If a mirror is
+// configured then the file is at <mirror>/<canonical name>/<file name>, which is the
+// layout of the working directory, so the working directory of a machine with network
+// access can be used as a mirror. Otherwise the original url is returned.
+func MirrorURL(canonicalName, fileName, url string) string {
+	return mirrorURL(Mirror(canonicalName), canonicalName, fileName, url)
+}
+
+func mirrorURL(mirror, canonicalName, fileName, url string) string {
+	if mirror == "" {
+		return url
+	}
+	if localPath, ok := LocalPath(mirror); ok {
+		return filepath.Join(localPath, filepath.FromSlash(canonicalName), filepath.FromSlash(fileName))
+	}
+	return strings.TrimSuffix(mirror, "/") + "/" + canonicalName + "/" + fileName
+}
+
+// LocalPath returns the path of a file:// url or of a local path, and false for any other url
+func LocalPath(url string) (string, bool) {
+	if strings.HasPrefix(url, "file://") {
+		return filepath.FromSlash(strings.TrimPrefix(url, "file://")), true
+	}
+	if url == "" || strings.Contains(url, "://") {
+		return "", false
+	}
+	return url, true
+}
+
+// CanDownload reports whether the files of the dataset can be fetched. In offline mode
+// they can only be copied from a local mirror.
+func CanDownload(canonicalName string) bool {
+	if !Config.Offline {
+		return true
+	}
+	_, ok := LocalPath(Mirror(canonicalName))
+	return ok
+}
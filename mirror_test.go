@@ -0,0 +1,55 @@
+package dldataset
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMirrorURL(t *testing.T) {
+	url := "https://s3.amazonaws.com/store.carml.org/datasets/coco2014/coco_val.record-00000-of-00001"
+	assert.Equal(t, url, mirrorURL("", "vision/coco2014", "coco_val.record-00000-of-00001", url))
+	assert.Equal(t,
+		"http://mirror.local/datasets/vision/coco2014/coco_val.record-00000-of-00001",
+		mirrorURL("http://mirror.local/datasets/", "vision/coco2014", "coco_val.record-00000-of-00001", url),
+	)
+	assert.Equal(t,
+		filepath.Join("/data/mirror", "vision", "coco2014", "coco_val.record-00000-of-00001"),
+		mirrorURL("file:///data/mirror", "vision/coco2014", "coco_val.record-00000-of-00001", url),
+	)
+	assert.Equal(t,
+		filepath.Join("mirror", "vision", "ilsvrc2012_validation", "n01440764", "ILSVRC2012_val_00000293.JPEG"),
+		mirrorURL("mirror", "vision/ilsvrc2012_validation", "n01440764/ILSVRC2012_val_00000293.JPEG", url),
+	)
+}
+
+func TestLocalPath(t *testing.T) {
+	path, ok := LocalPath("file:///data/mirror")
+	assert.True(t, ok)
+	assert.Equal(t, filepath.FromSlash("/data/mirror"), path)
+
+	path, ok = LocalPath("/data/mirror")
+	assert.True(t, ok)
+	assert.Equal(t, "/data/mirror", path)
+
+	_, ok = LocalPath("https://www.cs.toronto.edu/~kriz")
+	assert.False(t, ok)
+	_, ok = LocalPath("")
+	assert.False(t, ok)
+}
+
+func TestCanDownload(t *testing.T) {
+	defer func(offline bool, mirror string) {
+		Config.Offline, Config.MirrorURL = offline, mirror
+	}(Config.Offline, Config.MirrorURL)
+
+	Config.Offline, Config.MirrorURL = false, ""
+	assert.True(t, CanDownload("vision/cifar10"))
+	Config.Offline = true
+	assert.False(t, CanDownload("vision/cifar10"))
+	Config.MirrorURL = "http://mirror.local"
+	assert.False(t, CanDownload("vision/cifar10"))
+	Config.MirrorURL = "file:///data/mirror"
+	assert.True(t, CanDownload("vision/cifar10"))
+}
@@ -0,0 +1,149 @@
+package dldataset
+
+import (
+	"sync"
+
+	context "context"
+)
+
+// SkippedRecord describes a record that Next skipped because it could not be read
+type SkippedRecord struct {
+	// Dataset is the canonical name of the dataset the record belongs to
+	Dataset string
+	// Index is the position of the record in the dataset's Next order
+	Index int
+	// Err is the error returned when reading the record
+	Err error
+}
+
+// ErrorReport collects the records skipped by the SkipAndReport policy.
+// It is safe for concurrent use.
+type ErrorReport struct {
+	mu      sync.Mutex
+	skipped []SkippedRecord
+}
+
+// Add ...
+func (r *ErrorReport) Add(record SkippedRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.skipped = append(r.skipped, record)
+}
+
+// Skipped returns the skipped records in the order they were skipped
+func (r *ErrorReport) Skipped() []SkippedRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]SkippedRecord{}, r.skipped...)
+}
+
+// Len ...
+func (r *ErrorReport) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.skipped)
+}
+
+// ErrorPolicy decides what Next does when a record is corrupt or not supported.
+// It returns nil to skip the record, or the error to return from Next.
+type ErrorPolicy func(ctx context.Context, record SkippedRecord) error
+
+// FailOnError returns the error from Next. It is the behavior of datasets that
+// are not wrapped with WithErrorPolicy.
+func FailOnError() ErrorPolicy {
+	return func(ctx context.Context, record SkippedRecord) error {
+		return record.Err
+	}
+}
+
+// SkipAndLog logs a warning and skips the record
+func SkipAndLog() ErrorPolicy {
+	return func(ctx context.Context, record SkippedRecord) error {
+		log.WithError(record.Err).
+			WithField("dataset", record.Dataset).
+			WithField("index", record.Index).
+			Warn("skipping record")
+		return nil
+	}
+}
+
+// SkipAndReport adds the record to the report and skips it
+func SkipAndReport(report *ErrorReport) ErrorPolicy {
+	return func(ctx context.Context, record SkippedRecord) error {
+		report.Add(record)
+		return nil
+	}
+}
+
+// isRecordError reports whether the error only affects the record being read,
+// so that the following records can still be read
+func isRecordError(err error) bool {
+	return IsCorruptRecord(err) || IsNotSupported(err)
+}
+
+type errorPolicyDataset struct {
+	Dataset
+	policy ErrorPolicy
+	cursor int
+}
+
+// WithErrorPolicy returns a view of the dataset whose Next hands the records that are
+// corrupt or not supported, such as a JPEG that fails to decode, to the policy.
+// Other errors, such as a dataset that has not been downloaded, are always returned.
+func WithErrorPolicy(dataset Dataset, policy ErrorPolicy) Dataset {
+	return &errorPolicyDataset{
+		Dataset: dataset,
+		policy:  policy,
+	}
+}
+
+// New ...
+func (d *errorPolicyDataset) New(ctx context.Context) (Dataset, error) {
+	dataset, err := d.Dataset.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return WithErrorPolicy(dataset, d.policy), nil
+}
+
+// Next ...
+func (d *errorPolicyDataset) Next(ctx context.Context) (LabeledData, error) {
+	for {
+		data, err := d.Dataset.Next(ctx)
+		if err == nil {
+			d.cursor++
+			return data, nil
+		}
+		if !isRecordError(err) {
+			return nil, err
+		}
+		record := SkippedRecord{
+			Dataset: d.CanonicalName(),
+			Index:   d.cursor,
+			Err:     err,
+		}
+		// the datasets move past a record even if they fail to read it
+		d.cursor++
+		if err := d.policy(ctx, record); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Reset ...
+func (d *errorPolicyDataset) Reset(ctx context.Context) error {
+	if err := d.Dataset.Reset(ctx); err != nil {
+		return err
+	}
+	d.cursor = 0
+	return nil
+}
+
+// Seek ...
+func (d *errorPolicyDataset) Seek(ctx context.Context, index int) error {
+	if err := d.Dataset.Seek(ctx, index); err != nil {
+		return err
+	}
+	d.cursor = index
+	return nil
+}
@@ -0,0 +1,55 @@
+package dldataset
+
+import (
+	"testing"
+
+	context "context"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCorruptTestDataset(n int, corrupt ...string) Dataset {
+	return Map(newTestDataset(n), func(ctx context.Context, data LabeledData) (LabeledData, error) {
+		for _, name := range corrupt {
+			if data.Label() == name {
+				return nil, errors.Wrapf(ErrCorruptRecord, "cannot decode %s", name)
+			}
+		}
+		return data, nil
+	})
+}
+
+func TestErrorPolicy(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := readAll(ctx, WithErrorPolicy(newCorruptTestDataset(5, "2"), FailOnError()))
+	assert.True(t, IsCorruptRecord(err))
+
+	report := &ErrorReport{}
+	ds := WithErrorPolicy(newCorruptTestDataset(6, "0", "3", "4"), SkipAndReport(report))
+	res, err := readAll(ctx, ds)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "5"}, res)
+
+	skipped := report.Skipped()
+	if assert.Len(t, skipped, 3) {
+		assert.Equal(t, "test/dataset", skipped[0].Dataset)
+		assert.Equal(t, 0, skipped[0].Index)
+		assert.Equal(t, 3, skipped[1].Index)
+		assert.Equal(t, 4, skipped[2].Index)
+		assert.True(t, IsCorruptRecord(skipped[2].Err))
+	}
+
+	assert.NoError(t, ds.Seek(ctx, 4))
+	res, err = readAll(ctx, ds)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"5"}, res)
+	assert.Equal(t, 4, report.Len())
+
+	notFound := WithErrorPolicy(Map(newTestDataset(3), func(ctx context.Context, data LabeledData) (LabeledData, error) {
+		return nil, errors.Wrap(ErrNotDownloaded, "missing")
+	}), SkipAndReport(&ErrorReport{}))
+	_, err = readAll(ctx, notFound)
+	assert.True(t, IsNotDownloaded(err))
+}
@@ -0,0 +1,162 @@
+package dldataset
+
+import (
+	"sync"
+	"time"
+
+	context "context"
+)
+
+// DownloadProgress describes the progress of the download of a file of a dataset
+type DownloadProgress struct {
+	// Dataset is the canonical name of the dataset the file belongs to
+	Dataset string `json:"dataset"`
+	// FileName is the name of the file within the dataset
+	FileName string `json:"file_name"`
+	// URL is the url the file is downloaded from
+	URL       string `json:"url"`
+	BytesDone int64  `json:"bytes_done"`
+	// BytesTotal is the size of the file, or zero if the size is not known
+	BytesTotal int64 `json:"bytes_total"`
+	// Rate is the average number of bytes downloaded per second
+	Rate float64 `json:"rate"`
+	// ETA is the estimated time until the download finishes, or zero if it is not known
+	ETA  time.Duration `json:"eta"`
+	Done bool          `json:"done"`
+}
+
+// Fraction returns the fraction of the file that was downloaded, or zero if the size is not known
+func (p DownloadProgress) Fraction() float64 {
+	if p.BytesTotal <= 0 {
+		return 0
+	}
+	return float64(p.BytesDone) / float64(p.BytesTotal)
+}
+
+// ProgressFunc is called with the progress of the downloads of a dataset. It is called
+// from the goroutines that download the files, so it has to be safe for concurrent use.
+type ProgressFunc func(progress DownloadProgress)
+
+// ProgressChannel returns a ProgressFunc that sends the progress to the channel. Progress
+// events are dropped rather than blocking the download if the channel is full. The last
+// event of every file waits until it is received, or is dropped once ctx is done, so the
+// context has to be canceled if the channel is no longer read.
+func ProgressChannel(ctx context.Context, ch chan<- DownloadProgress) ProgressFunc {
+	return func(progress DownloadProgress) {
+		if progress.Done {
+			select {
+			case ch <- progress:
+			case <-ctx.Done():
+			}
+			return
+		}
+		select {
+		case ch <- progress:
+		default:
+		}
+	}
+}
+
+type progressKey struct{}
+
+// WithProgress returns a context whose dataset downloads report their progress to fn
+func WithProgress(ctx context.Context, fn ProgressFunc) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+// ProgressFromContext returns the ProgressFunc of the context, or nil if there is none
+func ProgressFromContext(ctx context.Context) ProgressFunc {
+	fn, _ := ctx.Value(progressKey{}).(ProgressFunc)
+	return fn
+}
+
+// ProgressInterval is the minimum time between two progress reports of a file
+var ProgressInterval = time.Second
+
+// ProgressTracker computes the rate and the ETA of the download of a file and reports them
+// to a ProgressFunc at most once per ProgressInterval. It is safe for concurrent use.
+type ProgressTracker struct {
+	mu       sync.Mutex
+	fn       ProgressFunc
+	progress DownloadProgress
+	start    time.Time
+	reported time.Time
+}
+
+// NewProgressTracker starts tracking the download of a file. If fn is nil then nothing is reported.
+func NewProgressTracker(fn ProgressFunc, dataset, fileName, url string, total int64) *ProgressTracker {
+	return &ProgressTracker{
+		fn: fn,
+		progress: DownloadProgress{
+			Dataset:    dataset,
+			FileName:   fileName,
+			URL:        url,
+			BytesTotal: total,
+		},
+		start: time.Now(),
+	}
+}
+
+// Write counts the bytes written, so that the tracker can be used with io.MultiWriter
+func (t *ProgressTracker) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	t.progress.BytesDone += int64(len(p))
+	progress, ok := t.due(time.Now())
+	t.mu.Unlock()
+	if ok {
+		t.report(progress)
+	}
+	return len(p), nil
+}
+
+// Update sets the number of bytes downloaded so far
+func (t *ProgressTracker) Update(done int64) {
+	t.mu.Lock()
+	t.progress.BytesDone = done
+	progress, ok := t.due(time.Now())
+	t.mu.Unlock()
+	if ok {
+		t.report(progress)
+	}
+}
+
+// due returns the progress to report if the last report is older than ProgressInterval
+func (t *ProgressTracker) due(now time.Time) (DownloadProgress, bool) {
+	if now.Sub(t.reported) < ProgressInterval {
+		return DownloadProgress{}, false
+	}
+	t.reported = now
+	return t.current(now), true
+}
+
+// Finish reports the last progress of the file
+func (t *ProgressTracker) Finish() DownloadProgress {
+	t.mu.Lock()
+	t.progress.Done = true
+	if t.progress.BytesTotal <= 0 {
+		t.progress.BytesTotal = t.progress.BytesDone
+	}
+	progress := t.current(time.Now())
+	t.mu.Unlock()
+	t.report(progress)
+	return progress
+}
+
+func (t *ProgressTracker) current(now time.Time) DownloadProgress {
+	progress := t.progress
+	elapsed := now.Sub(t.start).Seconds()
+	if elapsed > 0 {
+		progress.Rate = float64(progress.BytesDone) / elapsed
+	}
+	if progress.Rate > 0 && progress.BytesTotal > progress.BytesDone {
+		remaining := float64(progress.BytesTotal-progress.BytesDone) / progress.Rate
+		progress.ETA = time.Duration(remaining * float64(time.Second))
+	}
+	return progress
+}
+
+func (t *ProgressTracker) report(progress DownloadProgress) {
+	if t.fn != nil {
+		t.fn(progress)
+	}
+}
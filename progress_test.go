@@ -0,0 +1,82 @@
+package dldataset
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	context "context"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressTracker(t *testing.T) {
+	defer func(interval time.Duration) {
+		ProgressInterval = interval
+	}(ProgressInterval)
+	ProgressInterval = 0
+
+	ch := make(chan DownloadProgress, 100)
+	ctx := WithProgress(context.Background(), ProgressChannel(context.Background(), ch))
+	fn := ProgressFromContext(ctx)
+	assert.NotNil(t, fn)
+	assert.Nil(t, ProgressFromContext(context.Background()))
+
+	tracker := NewProgressTracker(fn, "vision/cifar10", "cifar-10-binary.tar.gz", "http://mirror.local", 10)
+	_, err := io.Copy(tracker, strings.NewReader("hello"))
+	assert.NoError(t, err)
+	progress := tracker.Finish()
+	close(ch)
+
+	events := []DownloadProgress{}
+	for event := range ch {
+		events = append(events, event)
+	}
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, "vision/cifar10", events[0].Dataset)
+		assert.Equal(t, "cifar-10-binary.tar.gz", events[0].FileName)
+		assert.Equal(t, int64(5), events[0].BytesDone)
+		assert.Equal(t, int64(10), events[0].BytesTotal)
+		assert.Equal(t, 0.5, events[0].Fraction())
+		assert.False(t, events[0].Done)
+		assert.Equal(t, progress, events[1])
+	}
+	assert.True(t, progress.Done)
+	assert.Equal(t, int64(5), progress.BytesDone)
+	assert.Equal(t, time.Duration(0), NewProgressTracker(nil, "", "", "", 0).Finish().ETA)
+}
+
+func TestProgressChannelFull(t *testing.T) {
+	ch := make(chan DownloadProgress)
+	ctx, cancel := context.WithCancel(context.Background())
+	fn := ProgressChannel(ctx, ch)
+
+	fn(DownloadProgress{BytesDone: 5})
+
+	done := make(chan struct{})
+	go func() {
+		fn(DownloadProgress{BytesDone: 10, Done: true})
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("the last event was dropped before the context was canceled")
+	case <-time.After(10 * time.Millisecond):
+	}
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the last event blocked after the context was canceled")
+	}
+}
+
+func TestProgressETA(t *testing.T) {
+	tracker := NewProgressTracker(nil, "vision/cifar10", "cifar-10-binary.tar.gz", "", 300)
+	tracker.start = time.Now().Add(-10 * time.Second)
+	tracker.Update(100)
+	progress := tracker.current(tracker.start.Add(10 * time.Second))
+	assert.Equal(t, 10.0, progress.Rate)
+	assert.Equal(t, 20*time.Second, progress.ETA)
+}
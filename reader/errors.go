@@ -0,0 +1,24 @@
+package reader
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/rai-project/dldataset"
+)
+
+// corruptError reports a record that ends before its header or payload does as a
+// dldataset.ErrCorruptRecord. Other errors, such as I/O errors, are wrapped as they are.
+func corruptError(err error, format string, args ...interface{}) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return errors.Wrapf(dldataset.ErrCorruptRecord, "%s: the record is truncated", fmt.Sprintf(format, args...))
+	}
+	return errors.Wrapf(err, format, args...)
+}
+
+// isRecordError reports whether the error only affects the record being read,
+// so that the following records can still be read
+func isRecordError(err error) bool {
+	return dldataset.IsCorruptRecord(err) || dldataset.IsNotSupported(err)
+}
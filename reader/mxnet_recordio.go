@@ -1,17 +1,21 @@
 package reader
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/binary"
 	"io"
 	"os"
+	"strings"
 	"unsafe"
 
 	context "context"
 
 	"github.com/pkg/errors"
+	"github.com/rai-project/dldataset"
 	"github.com/rai-project/image"
 	"github.com/rai-project/image/types"
+	"github.com/spf13/cast"
 )
 
 const (
@@ -19,41 +23,172 @@ const (
 )
 
 type RecordIOReader struct {
-	r io.ReadCloser
+	r          *os.File
+	opts       Options
+	prefetcher *Prefetcher
 }
 
-func NewRecordIOReader(path string) (*RecordIOReader, error) {
+// recordIORawRecord is a record that has been read but whose image has not been decoded
+type recordIORawRecord struct {
+	id     uint64
+	label  float32
+	data   []byte
+	offset int64
+}
+
+func NewRecordIOReader(path string, opts ...Option) (*RecordIOReader, error) {
 	r, err := os.Open(path)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot open %v", path)
 	}
 	return &RecordIOReader{
-		r: r,
+		r:    r,
+		opts: NewOptions(opts...),
 	}, nil
 }
 
 // Next ...
 func (r *RecordIOReader) Next(ctx context.Context) (*ImageRecord, error) {
-	f := r.r
+	if r.opts.PrefetchWorkers <= 0 {
+		raw, err := r.readRaw()
+		if err != nil {
+			return nil, err
+		}
+		return decodeRecordIO(ctx, raw)
+	}
+	if r.prefetcher == nil {
+		r.prefetcher = NewPrefetcher(
+			context.Background(),
+			func(ctx context.Context) (interface{}, error) {
+				return r.readRaw()
+			},
+			func(ctx context.Context, raw interface{}) (interface{}, error) {
+				return decodeRecordIO(ctx, raw.(*recordIORawRecord))
+			},
+			PrefetchWorkers(r.opts.PrefetchWorkers),
+			PrefetchBufferSize(r.opts.PrefetchBufferSize),
+			PrefetchOrdered(r.opts.PrefetchOrdered),
+		)
+	}
+	rec, err := r.prefetcher.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return rec.(*ImageRecord), nil
+}
+
+// readRaw reads the record at the current position. If the record is corrupt then
+// the reader resyncs to the next magic number, so that the following call reads the
+// next intact record instead of failing for the rest of the file.
+func (r *RecordIOReader) readRaw() (*recordIORawRecord, error) {
+	start, err := r.r.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get the position in %v", r.r.Name())
+	}
+	raw, err := readRawRecordIO(r.r)
+	if err == nil {
+		raw.offset = start
+		return raw, nil
+	}
+	if dldataset.IsEOF(err) {
+		return nil, err
+	}
+	if isRecordError(err) {
+		if serr := resyncRecordIO(r.r, start+1); serr != nil {
+			return nil, serr
+		}
+	}
+	return nil, errors.Wrapf(err, "cannot read the record at offset %v in %v", start, r.r.Name())
+}
+
+// resyncRecordIO positions the file at the first magic number found at or after
+// the offset, or at the end of the file if there is none
+func resyncRecordIO(f *os.File, offset int64) error {
+	magic := make([]byte, 4)
+	binary.LittleEndian.PutUint32(magic, kMagic)
+
+	buf := make([]byte, 64*1024)
+	for {
+		n, err := f.ReadAt(buf, offset)
+		if idx := bytes.Index(buf[:n], magic); idx >= 0 {
+			offset += int64(idx)
+			break
+		}
+		if err == io.EOF {
+			offset += int64(n)
+			break
+		}
+		if err != nil {
+			return errors.Wrapf(err, "cannot resync %v", f.Name())
+		}
+		// the magic number may straddle two reads
+		offset += int64(n - len(magic) + 1)
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return errors.Wrapf(err, "cannot seek to %v in %v", offset, f.Name())
+	}
+	return nil
+}
+
+func (r *RecordIOReader) stopPrefetcher() {
+	if r.prefetcher == nil {
+		return
+	}
+	r.prefetcher.Close()
+	r.prefetcher = nil
+}
+
+// Seek positions Next at a byte offset within the record file, which should be
+// the start of a record. It implements io.Seeker.
+func (r *RecordIOReader) Seek(offset int64, whence int) (int64, error) {
+	r.stopPrefetcher()
+	pos, err := r.r.Seek(offset, whence)
+	if err != nil {
+		return 0, errors.Wrapf(err, "cannot seek to %v in %v", offset, r.r.Name())
+	}
+	return pos, nil
+}
+
+// ReadAt reads the record that starts at the byte offset within the record file.
+// It does not change the position used by Next.
+func (r *RecordIOReader) ReadAt(ctx context.Context, offset int64) (*ImageRecord, error) {
+	info, err := r.r.Stat()
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot stat %v", r.r.Name())
+	}
+	if offset < 0 || offset >= info.Size() {
+		return nil, errors.Errorf("the offset %v is out of range of %v", offset, info.Size())
+	}
+	raw, err := readRawRecordIO(io.NewSectionReader(r.r, offset, info.Size()-offset))
+	if err != nil {
+		return nil, err
+	}
+	raw.offset = offset
+	return decodeRecordIO(ctx, raw)
+}
 
+func readRawRecordIO(f io.Reader) (*recordIORawRecord, error) {
 	var magic uint32
 	err := binary.Read(f, binary.LittleEndian, &magic)
-	if err != nil {
+	if err == io.EOF {
 		return nil, errors.Wrapf(err, "cannot read magic")
 	}
+	if err != nil {
+		return nil, corruptError(err, "cannot read magic")
+	}
 	if magic != kMagic {
-		return nil, errors.New("invalid magic number")
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "invalid magic number %x", magic)
 	}
 
 	var cflagLength uint32
 	err = binary.Read(f, binary.LittleEndian, &cflagLength)
 	if err != nil {
-		return nil, errors.Wrapf(err, "cannot read cflag / length")
+		return nil, corruptError(err, "cannot read cflag / length")
 	}
 
 	cflag := decodeFlag(cflagLength)
 	if cflag != 0 {
-		return nil, errors.New("only cflag==0 is currently supported")
+		return nil, errors.Wrapf(dldataset.ErrNotSupported, "only cflag==0 is currently supported, but got %v", cflag)
 	}
 
 	length := decodeLength(cflagLength)
@@ -63,25 +198,25 @@ func (r *RecordIOReader) Next(ctx context.Context) (*ImageRecord, error) {
 	var flag uint32
 	err = binary.Read(f, binary.LittleEndian, &flag)
 	if err != nil {
-		return nil, errors.Wrapf(err, "cannot read image header flag")
+		return nil, corruptError(err, "cannot read image header flag")
 	}
 
 	var label float32
 	err = binary.Read(f, binary.LittleEndian, &label)
 	if err != nil {
-		return nil, errors.Wrapf(err, "cannot read image header label")
+		return nil, corruptError(err, "cannot read image header label")
 	}
 
 	var imageId0 uint64
 	err = binary.Read(f, binary.LittleEndian, &imageId0)
 	if err != nil {
-		return nil, errors.Wrapf(err, "cannot read image header imageId0")
+		return nil, corruptError(err, "cannot read image header imageId0")
 	}
 
 	var imageId1 uint64
 	err = binary.Read(f, binary.LittleEndian, &imageId1)
 	if err != nil {
-		return nil, errors.Wrapf(err, "cannot read image header imageId1")
+		return nil, corruptError(err, "cannot read image header imageId1")
 	}
 
 	headerSize := uint32(unsafe.Sizeof(flag) +
@@ -89,37 +224,120 @@ func (r *RecordIOReader) Next(ctx context.Context) (*ImageRecord, error) {
 		unsafe.Sizeof(imageId0) +
 		unsafe.Sizeof(imageId1))
 
+	if length < headerSize {
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "the record length %v is smaller than the image header", length)
+	}
+
 	bts := make([]byte, length-headerSize)
 	_, err = io.ReadFull(f, bts)
 	if err != nil {
-		return nil, errors.Wrapf(err, "cannot read image jpeg data")
+		return nil, corruptError(err, "cannot read image jpeg data")
 	}
 
 	padding := make([]byte, paddedLength-length)
 	io.ReadFull(f, padding)
 
-	img, err := image.Read(bytes.NewBuffer(bts), image.Context(nil))
+	return &recordIORawRecord{
+		id:    imageId1,
+		label: label,
+		data:  bts,
+	}, nil
+}
+
+func decodeRecordIO(ctx context.Context, raw *recordIORawRecord) (*ImageRecord, error) {
+	img, err := image.Read(bytes.NewBuffer(raw.data), image.Context(nil))
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "cannot decode the image of record %v: %v", raw.id, err)
 	}
 
 	rgbImage, ok := img.(*types.RGBImage)
 	if !ok {
-		return nil, errors.Errorf("expecting an rgb image")
+		return nil, errors.Wrapf(dldataset.ErrNotSupported, "expecting an rgb image for record %v", raw.id)
 	}
 
 	return &ImageRecord{
-		ID:         imageId1,
-		LabelIndex: label,
+		ID:         raw.id,
+		LabelIndex: raw.label,
 		Image:      rgbImage,
+		Offset:     raw.offset,
 	}, nil
-
 }
 
 func (r *RecordIOReader) Close() error {
+	r.stopPrefetcher()
 	return r.r.Close()
 }
 
+// ReadRecordIOIndex reads an MXNet .idx file, which maps each record key to the
+// byte offset of the record within the .rec file.
+func ReadRecordIOIndex(path string) (map[string]int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot open %v", path)
+	}
+	defer f.Close()
+
+	offsets := map[string]int64{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "invalid index line %q in %v", scanner.Text(), path)
+		}
+		offset, err := cast.ToInt64E(fields[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid offset %q in %v", fields[1], path)
+		}
+		offsets[fields[0]] = offset
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v", path)
+	}
+	return offsets, nil
+}
+
+// VerifyRecordIO checks that every offset of the index file points at the start of a
+// record that lies within the record file. A record file truncated by an interrupted
+// download fails the check with an error wrapping dldataset.ErrCorruptRecord.
+func VerifyRecordIO(recordPath, indexPath string) error {
+	offsets, err := ReadRecordIOIndex(indexPath)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(recordPath)
+	if err != nil {
+		return errors.Wrapf(err, "cannot open %v", recordPath)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrapf(err, "cannot stat %v", recordPath)
+	}
+	size := info.Size()
+
+	header := make([]byte, 8)
+	for key, offset := range offsets {
+		if offset < 0 || offset+int64(len(header)) > size {
+			return errors.Wrapf(dldataset.ErrCorruptRecord, "the record %v at offset %v is past the end of %v", key, offset, recordPath)
+		}
+		if _, err := f.ReadAt(header, offset); err != nil {
+			return errors.Wrapf(err, "cannot read the record %v at offset %v in %v", key, offset, recordPath)
+		}
+		if magic := binary.LittleEndian.Uint32(header[:4]); magic != kMagic {
+			return errors.Wrapf(dldataset.ErrCorruptRecord, "invalid magic number %x for the record %v at offset %v in %v", magic, key, offset, recordPath)
+		}
+		length := decodeLength(binary.LittleEndian.Uint32(header[4:]))
+		paddedLength := ((length + uint32(3)) >> uint32(2)) << uint32(2)
+		if end := offset + int64(len(header)) + int64(paddedLength); end > size {
+			return errors.Wrapf(dldataset.ErrCorruptRecord, "the record %v at offset %v ends at %v past the end of %v, the file is truncated", key, offset, end, recordPath)
+		}
+	}
+	return nil
+}
+
 /*!
  * \brief decode the flag part of lrecord
  * \param rec the lrecord
@@ -3,8 +3,11 @@ package reader
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 	"unsafe"
 
@@ -13,6 +16,7 @@ import (
 	goimage "image"
 	"image/png"
 
+	"github.com/rai-project/dldataset"
 	"github.com/rai-project/image"
 	"github.com/rai-project/image/types"
 	_ "github.com/rai-project/tracer/noop"
@@ -123,3 +127,145 @@ func toRGBAImage(rgbImage *types.RGBImage) *goimage.RGBA {
 
 	return inputImage
 }
+
+func writeRecordIO(buf *bytes.Buffer, id uint64, data []byte) {
+	headerSize := 24
+	length := uint32(headerSize + len(data))
+	binary.Write(buf, binary.LittleEndian, kMagic)
+	binary.Write(buf, binary.LittleEndian, length)
+	binary.Write(buf, binary.LittleEndian, uint32(0))
+	binary.Write(buf, binary.LittleEndian, float32(id))
+	binary.Write(buf, binary.LittleEndian, uint64(0))
+	binary.Write(buf, binary.LittleEndian, id)
+	buf.Write(data)
+	buf.Write(make([]byte, ((length+3)>>2<<2)-length))
+}
+
+// writeCorruptRecordIO writes three records with garbage between the first and the
+// second record and a record whose length runs past the third record
+func writeCorruptRecordIO(t *testing.T, path string) {
+	buf := &bytes.Buffer{}
+	writeRecordIO(buf, 1, []byte("first"))
+	buf.Write([]byte("garbage that is not a record"))
+	writeRecordIO(buf, 2, []byte("second"))
+	binary.Write(buf, binary.LittleEndian, kMagic)
+	binary.Write(buf, binary.LittleEndian, uint32(1000))
+	writeRecordIO(buf, 3, []byte("third"))
+	assert.NoError(t, ioutil.WriteFile(path, buf.Bytes(), 0644))
+}
+
+func TestMXNetRecordIOResync(t *testing.T) {
+	writeCorruptRecordIO(t, "resync.rec")
+	defer os.Remove("resync.rec")
+
+	r, err := NewRecordIOReader("resync.rec")
+	assert.NoError(t, err)
+	defer r.Close()
+
+	ids := []uint64{}
+	corrupt := 0
+	for {
+		raw, err := r.readRaw()
+		if dldataset.IsEOF(err) {
+			break
+		}
+		if err != nil {
+			assert.True(t, dldataset.IsCorruptRecord(err))
+			corrupt++
+			continue
+		}
+		ids = append(ids, raw.id)
+	}
+	assert.Equal(t, []uint64{1, 2, 3}, ids)
+	assert.Equal(t, 2, corrupt)
+}
+
+func TestMXNetRecordIOResyncPrefetch(t *testing.T) {
+	ctx := context.Background()
+	writeCorruptRecordIO(t, "resync_prefetch.rec")
+	defer os.Remove("resync_prefetch.rec")
+
+	r, err := NewRecordIOReader("resync_prefetch.rec", PrefetchWorkers(2), PrefetchBufferSize(4))
+	assert.NoError(t, err)
+	defer r.Close()
+
+	// the payloads are not images, so every record fails to decode, and the
+	// corrupt parts of the file fail to read without ending the stream
+	readErrors, decodeErrors := 0, 0
+	for ii := 0; ii < 10; ii++ {
+		_, err := r.Next(ctx)
+		if dldataset.IsEOF(err) {
+			break
+		}
+		if assert.Error(t, err) {
+			assert.True(t, dldataset.IsCorruptRecord(err) || dldataset.IsNotSupported(err))
+		}
+		if strings.Contains(err.Error(), "cannot read the record") {
+			readErrors++
+		} else {
+			decodeErrors++
+		}
+	}
+	assert.Equal(t, 2, readErrors)
+	assert.Equal(t, 3, decodeErrors)
+
+	_, err = r.Next(ctx)
+	assert.True(t, dldataset.IsEOF(err))
+}
+
+// recordIODataset is the part of a dataset that WithErrorPolicy uses
+type recordIODataset struct {
+	dldataset.Dataset
+	r *RecordIOReader
+}
+
+func (d *recordIODataset) CanonicalName() string {
+	return "test/recordio"
+}
+
+func (d *recordIODataset) Next(ctx context.Context) (dldataset.LabeledData, error) {
+	_, err := d.r.Next(ctx)
+	return nil, err
+}
+
+func TestMXNetRecordIOErrorPolicyPrefetch(t *testing.T) {
+	ctx := context.Background()
+	writeCorruptRecordIO(t, "policy_prefetch.rec")
+	defer os.Remove("policy_prefetch.rec")
+
+	r, err := NewRecordIOReader("policy_prefetch.rec", PrefetchWorkers(2))
+	assert.NoError(t, err)
+	defer r.Close()
+
+	report := &dldataset.ErrorReport{}
+	dataset := dldataset.WithErrorPolicy(&recordIODataset{r: r}, dldataset.SkipAndReport(report))
+	_, err = dataset.Next(ctx)
+	assert.True(t, dldataset.IsEOF(err))
+	assert.Equal(t, 5, report.Len())
+	for ii, skipped := range report.Skipped() {
+		assert.Equal(t, ii, skipped.Index)
+	}
+}
+
+func TestVerifyRecordIO(t *testing.T) {
+	buf := &bytes.Buffer{}
+	writeRecordIO(buf, 1, []byte("first"))
+	secondOffset := buf.Len()
+	writeRecordIO(buf, 2, []byte("second"))
+
+	assert.NoError(t, ioutil.WriteFile("verify.idx", []byte(fmt.Sprintf("1\t0\n2\t%d\n", secondOffset)), 0644))
+	defer os.Remove("verify.idx")
+
+	assert.NoError(t, ioutil.WriteFile("verify.rec", buf.Bytes(), 0644))
+	defer os.Remove("verify.rec")
+	assert.NoError(t, VerifyRecordIO("verify.rec", "verify.idx"))
+
+	// a download that was interrupted in the middle of the last record
+	assert.NoError(t, ioutil.WriteFile("verify.rec", buf.Bytes()[:buf.Len()-4], 0644))
+	err := VerifyRecordIO("verify.rec", "verify.idx")
+	assert.True(t, dldataset.IsCorruptRecord(err))
+
+	assert.NoError(t, ioutil.WriteFile("verify.rec", buf.Bytes()[:secondOffset], 0644))
+	err = VerifyRecordIO("verify.rec", "verify.idx")
+	assert.True(t, dldataset.IsCorruptRecord(err))
+}
@@ -0,0 +1,49 @@
+package reader
+
+// Options ...
+type Options struct {
+	// PrefetchWorkers is the number of goroutines that decode records. When it is zero
+	// records are read and decoded on the caller's goroutine.
+	PrefetchWorkers int
+	// PrefetchBufferSize is the maximum number of records read ahead of the caller
+	PrefetchBufferSize int
+	// PrefetchOrdered returns the records in file order when set
+	PrefetchOrdered bool
+}
+
+// Option ...
+type Option func(*Options)
+
+// NewOptions ...
+func NewOptions(opts ...Option) Options {
+	options := Options{
+		PrefetchWorkers:    0,
+		PrefetchBufferSize: 0,
+		PrefetchOrdered:    true,
+	}
+	for _, o := range opts {
+		o(&options)
+	}
+	return options
+}
+
+// PrefetchWorkers ...
+func PrefetchWorkers(n int) Option {
+	return func(o *Options) {
+		o.PrefetchWorkers = n
+	}
+}
+
+// PrefetchBufferSize ...
+func PrefetchBufferSize(n int) Option {
+	return func(o *Options) {
+		o.PrefetchBufferSize = n
+	}
+}
+
+// PrefetchOrdered ...
+func PrefetchOrdered(ordered bool) Option {
+	return func(o *Options) {
+		o.PrefetchOrdered = ordered
+	}
+}
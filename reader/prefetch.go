@@ -0,0 +1,229 @@
+package reader
+
+import (
+	"sync"
+
+	context "context"
+
+	"github.com/pkg/errors"
+)
+
+var errPrefetcherClosed = errors.New("the prefetcher is closed")
+
+// ReadFunc reads the next raw record from a file. It is only ever called from a single goroutine.
+// An error that wraps dldataset.ErrCorruptRecord or dldataset.ErrNotSupported only affects
+// the record being read, and the read function is called again for the next record.
+type ReadFunc func(ctx context.Context) (interface{}, error)
+
+// DecodeFunc decodes a raw record. It is called concurrently from the decode workers.
+type DecodeFunc func(ctx context.Context, raw interface{}) (interface{}, error)
+
+type prefetchResult struct {
+	value interface{}
+	err   error
+	// final is set on the error that stops the read function, which is the last result
+	final bool
+}
+
+type prefetchJob struct {
+	raw    interface{}
+	result chan prefetchResult
+}
+
+// Prefetcher reads records on a background goroutine and decodes them on a pool
+// of workers. At most the buffer size of records are read ahead of the consumer.
+// In ordered mode the records are returned in the order they were read, otherwise
+// they are returned as soon as they are decoded.
+type Prefetcher struct {
+	opts    Options
+	read    ReadFunc
+	decode  DecodeFunc
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	jobs    chan prefetchJob
+	ordered chan chan prefetchResult
+	// pending is the future of the record Next was waiting for when its context was
+	// done, which the next call waits for again so that no record is skipped
+	pending chan prefetchResult
+	results chan prefetchResult
+	err     error
+}
+
+// NewPrefetcher starts the read and decode goroutines. Close must be called to stop them.
+func NewPrefetcher(ctx context.Context, read ReadFunc, decode DecodeFunc, opts ...Option) *Prefetcher {
+	options := NewOptions(opts...)
+	if options.PrefetchWorkers <= 0 {
+		options.PrefetchWorkers = 1
+	}
+	if options.PrefetchBufferSize < options.PrefetchWorkers {
+		options.PrefetchBufferSize = options.PrefetchWorkers
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	p := &Prefetcher{
+		opts:   options,
+		read:   read,
+		decode: decode,
+		cancel: cancel,
+		jobs:   make(chan prefetchJob, options.PrefetchBufferSize),
+	}
+	if options.PrefetchOrdered {
+		p.ordered = make(chan chan prefetchResult, options.PrefetchBufferSize)
+	} else {
+		p.results = make(chan prefetchResult, options.PrefetchBufferSize)
+	}
+
+	workers := sync.WaitGroup{}
+	workers.Add(options.PrefetchWorkers)
+	for ii := 0; ii < options.PrefetchWorkers; ii++ {
+		go func() {
+			defer workers.Done()
+			p.work(ctx)
+		}()
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		err := p.readAll(ctx)
+		close(p.jobs)
+		workers.Wait()
+		if !options.PrefetchOrdered {
+			// the read error is only reported once all the records read before it are returned
+			select {
+			case p.results <- prefetchResult{err: err, final: true}:
+			case <-ctx.Done():
+			}
+			close(p.results)
+		}
+	}()
+
+	return p
+}
+
+func (p *Prefetcher) readAll(ctx context.Context) error {
+	if p.opts.PrefetchOrdered {
+		defer close(p.ordered)
+	}
+	for {
+		raw, err := p.read(ctx)
+		if isRecordError(err) {
+			// the read function skipped past the record, so the error only affects this record
+			if err := p.send(ctx, prefetchResult{err: err}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			if p.opts.PrefetchOrdered {
+				p.send(ctx, prefetchResult{err: err, final: true})
+			}
+			return err
+		}
+
+		job := prefetchJob{raw: raw}
+		if p.opts.PrefetchOrdered {
+			job.result = make(chan prefetchResult, 1)
+			select {
+			case p.ordered <- job.result:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		select {
+		case p.jobs <- job:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// send queues a result that is not decoded, in the order of the records read
+func (p *Prefetcher) send(ctx context.Context, result prefetchResult) error {
+	if p.opts.PrefetchOrdered {
+		future := make(chan prefetchResult, 1)
+		future <- result
+		select {
+		case p.ordered <- future:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	select {
+	case p.results <- result:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *Prefetcher) work(ctx context.Context) {
+	for job := range p.jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		value, err := p.decode(ctx, job.raw)
+		result := prefetchResult{value: value, err: err}
+		if job.result != nil {
+			job.result <- result
+			continue
+		}
+		select {
+		case p.results <- result:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Next returns the next decoded record. Once the read function fails with an error
+// that is not a record error, that error is returned by this and every subsequent call.
+func (p *Prefetcher) Next(ctx context.Context) (interface{}, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	var result prefetchResult
+	if p.opts.PrefetchOrdered {
+		if p.pending == nil {
+			var ok bool
+			select {
+			case p.pending, ok = <-p.ordered:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			if !ok {
+				return nil, errPrefetcherClosed
+			}
+		}
+		select {
+		case result = <-p.pending:
+			p.pending = nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	} else {
+		var ok bool
+		select {
+		case result, ok = <-p.results:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if !ok {
+			return nil, errPrefetcherClosed
+		}
+	}
+
+	if result.final {
+		p.err = result.err
+	}
+	return result.value, result.err
+}
+
+// Close stops the read and decode goroutines and waits for them to exit
+func (p *Prefetcher) Close() error {
+	p.cancel()
+	p.wg.Wait()
+	return nil
+}
@@ -0,0 +1,129 @@
+package reader
+
+import (
+	"io"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	context "context"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func countingReadFunc(n int) ReadFunc {
+	ii := 0
+	return func(ctx context.Context) (interface{}, error) {
+		if ii >= n {
+			return nil, io.EOF
+		}
+		ii++
+		return ii - 1, nil
+	}
+}
+
+func slowDecodeFunc(ctx context.Context, raw interface{}) (interface{}, error) {
+	time.Sleep(time.Duration(rand.Intn(100)) * time.Microsecond)
+	return raw.(int) * 2, nil
+}
+
+func TestPrefetcherOrdered(t *testing.T) {
+	ctx := context.Background()
+
+	p := NewPrefetcher(ctx, countingReadFunc(100), slowDecodeFunc, PrefetchWorkers(4), PrefetchBufferSize(8))
+	defer p.Close()
+
+	for ii := 0; ii < 100; ii++ {
+		val, err := p.Next(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2*ii, val)
+	}
+
+	_, err := p.Next(ctx)
+	assert.Equal(t, io.EOF, err)
+
+	_, err = p.Next(ctx)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestPrefetcherUnordered(t *testing.T) {
+	ctx := context.Background()
+
+	p := NewPrefetcher(ctx, countingReadFunc(100), slowDecodeFunc, PrefetchWorkers(4), PrefetchOrdered(false))
+	defer p.Close()
+
+	vals := []int{}
+	for {
+		val, err := p.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		vals = append(vals, val.(int))
+	}
+
+	sort.Ints(vals)
+	assert.Len(t, vals, 100)
+	for ii, val := range vals {
+		assert.Equal(t, 2*ii, val)
+	}
+}
+
+func TestPrefetcherCancel(t *testing.T) {
+	p := NewPrefetcher(
+		context.Background(),
+		countingReadFunc(100),
+		func(ctx context.Context, raw interface{}) (interface{}, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+		PrefetchWorkers(2),
+	)
+	defer p.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := p.Next(ctx)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestPrefetcherCancelResume(t *testing.T) {
+	release := make(chan struct{})
+	p := NewPrefetcher(
+		context.Background(),
+		countingReadFunc(10),
+		func(ctx context.Context, raw interface{}) (interface{}, error) {
+			if raw.(int) == 3 {
+				<-release
+			}
+			return raw.(int) * 2, nil
+		},
+		PrefetchWorkers(2),
+		PrefetchBufferSize(4),
+	)
+	defer p.Close()
+
+	ctx := context.Background()
+	for ii := 0; ii < 3; ii++ {
+		val, err := p.Next(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2*ii, val)
+	}
+
+	// the context is done while the fourth record is being decoded
+	timeoutCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	_, err := p.Next(timeoutCtx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+
+	close(release)
+	for ii := 3; ii < 10; ii++ {
+		val, err := p.Next(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2*ii, val)
+	}
+	_, err = p.Next(ctx)
+	assert.Equal(t, io.EOF, err)
+}
@@ -7,6 +7,8 @@ type ImageRecord struct {
 	ID         uint64
 	LabelIndex float32
 	Image      *types.RGBImage
+	// Offset is the byte offset of the record within the record file
+	Offset int64
 }
 
 // ImageSegmentationRecord ...
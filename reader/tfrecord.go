@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/rai-project/dldataset"
 	"github.com/rai-project/image"
 	"github.com/rai-project/image/types"
 	"github.com/ubccr/terf"
@@ -17,42 +18,148 @@ import (
 
 // TFRecordReader ...
 type TFRecordReader struct {
-	r io.ReadCloser
+	path       string
+	r          *os.File
+	index      *TFRecordIndex
+	opts       Options
+	prefetcher *Prefetcher
 	*terf.Reader
 }
 
+// ExampleDecodeFunc converts a record into the value returned by NextDecoded
+type ExampleDecodeFunc func(ctx context.Context, rec *protobuf.Example) (interface{}, error)
+
 // NewTFRecordReader ...
-func NewTFRecordReader(path string) (*TFRecordReader, error) {
+func NewTFRecordReader(path string, opts ...Option) (*TFRecordReader, error) {
 	r, err := os.Open(path)
 	if err != nil {
 		return nil, errors.Wrapf(err, "cannot open %v", path)
 	}
 	return &TFRecordReader{
+		path:   path,
 		r:      r,
+		opts:   NewOptions(opts...),
 		Reader: terf.NewReader(r),
 	}, nil
 }
 
-// NextRecord ...
+// Seek positions NextRecord at a byte offset within the record file, which should be
+// the start of a record. It implements io.Seeker.
+func (r *TFRecordReader) Seek(offset int64, whence int) (int64, error) {
+	r.stopPrefetcher()
+	pos, err := r.r.Seek(offset, whence)
+	if err != nil {
+		return 0, errors.Wrapf(err, "cannot seek to %v in %v", offset, r.path)
+	}
+	r.Reader = terf.NewReader(r.r)
+	return pos, nil
+}
+
+// Index returns the offset index of the record file. The index is built on first use
+// and persisted next to the record file.
+func (r *TFRecordReader) Index(ctx context.Context) (*TFRecordIndex, error) {
+	if r.index != nil {
+		return r.index, nil
+	}
+	idx, err := LoadTFRecordIndex(ctx, r.path)
+	if err != nil {
+		return nil, err
+	}
+	r.index = idx
+	return idx, nil
+}
+
+// ReadRecordAt reads the record that starts at the byte offset within the record file.
+// It does not change the position used by NextRecord.
+func (r *TFRecordReader) ReadRecordAt(ctx context.Context, offset int64) (*protobuf.Example, error) {
+	info, err := r.r.Stat()
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot stat %v", r.path)
+	}
+	if offset < 0 || offset >= info.Size() {
+		return nil, errors.Wrapf(dldataset.ErrNotFound, "the offset %v is out of range of %v", offset, info.Size())
+	}
+	rec, err := nextExample(terf.NewReader(io.NewSectionReader(r.r, offset, info.Size()-offset)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read record at offset %v in %v", offset, r.path)
+	}
+	return rec, nil
+}
+
+// nextExample reads the next record. terf does not distinguish a truncated record or a
+// checksum failure from other read errors, so every error but io.EOF is reported as
+// a dldataset.ErrCorruptRecord.
+func nextExample(r *terf.Reader) (*protobuf.Example, error) {
+	rec, err := r.Next()
+	if err == io.EOF {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "cannot read tfrecord: %v", err)
+	}
+	return rec, nil
+}
+
+// NextRecord returns the next record without decoding it. It must not be mixed
+// with Next or NextDecoded when prefetching is enabled.
 func (r *TFRecordReader) NextRecord(ctx context.Context) (*protobuf.Example, error) {
-	nxt, err := r.Reader.Next()
+	nxt, err := nextExample(r.Reader)
 	if err != nil {
 		return nil, err
 	}
 	return nxt, nil
 }
 
+// NextDecoded returns the next record converted by decode. When prefetching is
+// enabled the records are converted on the decode workers, and every call on
+// the reader must use the same decode function.
+func (r *TFRecordReader) NextDecoded(ctx context.Context, decode ExampleDecodeFunc) (interface{}, error) {
+	if r.opts.PrefetchWorkers <= 0 {
+		nxt, err := nextExample(r.Reader)
+		if err != nil {
+			return nil, err
+		}
+		return decode(ctx, nxt)
+	}
+	if r.prefetcher == nil {
+		r.prefetcher = NewPrefetcher(
+			context.Background(),
+			func(ctx context.Context) (interface{}, error) {
+				return nextExample(r.Reader)
+			},
+			func(ctx context.Context, raw interface{}) (interface{}, error) {
+				return decode(ctx, raw.(*protobuf.Example))
+			},
+			PrefetchWorkers(r.opts.PrefetchWorkers),
+			PrefetchBufferSize(r.opts.PrefetchBufferSize),
+			PrefetchOrdered(r.opts.PrefetchOrdered),
+		)
+	}
+	return r.prefetcher.Next(ctx)
+}
+
+func (r *TFRecordReader) stopPrefetcher() {
+	if r.prefetcher == nil {
+		return
+	}
+	r.prefetcher.Close()
+	r.prefetcher = nil
+}
+
 // Next ...
 func (r *TFRecordReader) Next(ctx context.Context) (*ImageRecord, error) {
-	nxt, err := r.Reader.Next()
+	rec, err := r.NextDecoded(ctx, decodeTFRecordImage)
 	if err != nil {
 		return nil, err
 	}
+	return rec.(*ImageRecord), nil
+}
 
+func decodeTFRecordImage(ctx context.Context, nxt *protobuf.Example) (interface{}, error) {
 	imgRecord := new(terf.Image)
-	err = imgRecord.UnmarshalExample(nxt)
+	err := imgRecord.UnmarshalExample(nxt)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to unmarshal image")
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "unable to unmarshal image: %v", err)
 	}
 
 	if strings.ToLower(imgRecord.Format) == "cifar" {
@@ -80,12 +187,12 @@ func (r *TFRecordReader) Next(ctx context.Context) (*ImageRecord, error) {
 
 	img, err := image.Read(bytes.NewBuffer(imgRecord.Raw), image.Context(nil))
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "cannot decode the image of record %v: %v", imgRecord.ID, err)
 	}
 
 	rgbImage, ok := img.(*types.RGBImage)
 	if !ok {
-		return nil, errors.Errorf("expecting an rgb image")
+		return nil, errors.Wrapf(dldataset.ErrNotSupported, "expecting an rgb image for record %v", imgRecord.ID)
 	}
 
 	return &ImageRecord{
@@ -97,5 +204,6 @@ func (r *TFRecordReader) Next(ctx context.Context) (*ImageRecord, error) {
 
 // Close ...
 func (r *TFRecordReader) Close() error {
+	r.stopPrefetcher()
 	return r.r.Close()
 }
@@ -0,0 +1,246 @@
+package reader
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	context "context"
+
+	"github.com/Unknwon/com"
+	"github.com/pkg/errors"
+	"github.com/rai-project/dldataset"
+	"github.com/rai-project/dldataset/reader/tfrecord"
+	"github.com/spf13/cast"
+	"github.com/ubccr/terf"
+)
+
+const (
+	// tfrecordHeaderSize is the size of the length (uint64) and the masked crc
+	// of the length (uint32) that precede every record
+	tfrecordHeaderSize = 12
+	// tfrecordFooterSize is the size of the masked crc of the record data
+	tfrecordFooterSize = 4
+)
+
+// TFRecordIndexEntry ...
+type TFRecordIndexEntry struct {
+	Offset   int64
+	SourceID string
+	FileName string
+}
+
+// TFRecordIndex maps the records within a TFRecord file to their byte offsets.
+// Records are keyed by their image/source_id and image/filename features.
+type TFRecordIndex struct {
+	Entries    []TFRecordIndexEntry
+	bySourceID map[string]int
+	byFileName map[string]int
+}
+
+// Len ...
+func (idx *TFRecordIndex) Len() int {
+	return len(idx.Entries)
+}
+
+// Lookup returns the offset of the record whose source id or file name matches the key
+func (idx *TFRecordIndex) Lookup(key string) (int64, bool) {
+	if ii, ok := idx.bySourceID[key]; ok {
+		return idx.Entries[ii].Offset, true
+	}
+	if ii, ok := idx.byFileName[key]; ok {
+		return idx.Entries[ii].Offset, true
+	}
+	return 0, false
+}
+
+func newTFRecordIndex(entries []TFRecordIndexEntry) *TFRecordIndex {
+	idx := &TFRecordIndex{
+		Entries:    entries,
+		bySourceID: map[string]int{},
+		byFileName: map[string]int{},
+	}
+	for ii, entry := range entries {
+		if entry.SourceID != "" {
+			idx.bySourceID[entry.SourceID] = ii
+		}
+		if entry.FileName != "" {
+			idx.byFileName[entry.FileName] = ii
+		}
+	}
+	return idx
+}
+
+// TFRecordIndexPath returns the path where the index of the record file is persisted
+func TFRecordIndexPath(recordPath string) string {
+	return recordPath + ".idx"
+}
+
+// LoadTFRecordIndex loads the index persisted next to the record file. If the index
+// does not exist or is older than the record file, then it is rebuilt and persisted.
+func LoadTFRecordIndex(ctx context.Context, recordPath string) (*TFRecordIndex, error) {
+	indexPath := TFRecordIndexPath(recordPath)
+	if isIndexFresh(recordPath, indexPath) {
+		idx, err := readTFRecordIndex(indexPath)
+		if err == nil {
+			return idx, nil
+		}
+		log.WithError(err).WithField("path", indexPath).Warn("failed to read the tfrecord index, rebuilding it")
+	}
+
+	idx, err := BuildTFRecordIndex(ctx, recordPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeTFRecordIndex(indexPath, idx); err != nil {
+		log.WithError(err).WithField("path", indexPath).Warn("failed to persist the tfrecord index")
+	}
+
+	return idx, nil
+}
+
+// BuildTFRecordIndex scans the record file and records the offset of each record.
+func BuildTFRecordIndex(ctx context.Context, recordPath string) (*TFRecordIndex, error) {
+	f, err := os.Open(recordPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot open %v", recordPath)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot stat %v", recordPath)
+	}
+
+	r := bufio.NewReader(f)
+	entries := []TFRecordIndexEntry{}
+	offset := int64(0)
+	header := make([]byte, tfrecordHeaderSize)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		_, err := io.ReadFull(r, header)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, corruptError(err, "cannot read record header at offset %v in %v", offset, recordPath)
+		}
+		length := binary.LittleEndian.Uint64(header[:8])
+		recordSize := int64(tfrecordHeaderSize) + int64(length) + int64(tfrecordFooterSize)
+		// a corrupt length would otherwise allocate up to the largest uint64
+		if length > uint64(info.Size()) || offset+recordSize > info.Size() {
+			return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "the record length %v at offset %v exceeds the size of %v", length, offset, recordPath)
+		}
+
+		record := make([]byte, recordSize)
+		copy(record, header)
+		if _, err := io.ReadFull(r, record[tfrecordHeaderSize:]); err != nil {
+			return nil, corruptError(err, "cannot read record at offset %v in %v", offset, recordPath)
+		}
+
+		example, err := nextExample(terf.NewReader(bytes.NewReader(record)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot decode record at offset %v in %v", offset, recordPath)
+		}
+
+		entries = append(entries, TFRecordIndexEntry{
+			Offset:   offset,
+			SourceID: tfrecord.FeatureString(example, "image/source_id"),
+			FileName: tfrecord.FeatureString(example, "image/filename"),
+		})
+
+		offset += recordSize
+	}
+
+	return newTFRecordIndex(entries), nil
+}
+
+func isIndexFresh(recordPath, indexPath string) bool {
+	if !com.IsFile(indexPath) {
+		return false
+	}
+	recordInfo, err := os.Stat(recordPath)
+	if err != nil {
+		return false
+	}
+	indexInfo, err := os.Stat(indexPath)
+	if err != nil {
+		return false
+	}
+	return !indexInfo.ModTime().Before(recordInfo.ModTime())
+}
+
+// readTFRecordIndex reads an index file where each line is of the form "offset\tsource_id\tfilename"
+func readTFRecordIndex(indexPath string) (*TFRecordIndex, error) {
+	f, err := os.Open(indexPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot open %v", indexPath)
+	}
+	defer f.Close()
+
+	entries := []TFRecordIndexEntry{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 3 {
+			return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "invalid index line %q in %v", line, indexPath)
+		}
+		offset, err := cast.ToInt64E(fields[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid offset %q in %v", fields[0], indexPath)
+		}
+		entries = append(entries, TFRecordIndexEntry{
+			Offset:   offset,
+			SourceID: fields[1],
+			FileName: fields[2],
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v", indexPath)
+	}
+	return newTFRecordIndex(entries), nil
+}
+
+// writeTFRecordIndex writes the index to a temporary file of its own that is renamed, so that
+// processes that build the index of the same record file at the same time do not interleave
+// their writes and readers never see a partial index
+func writeTFRecordIndex(indexPath string, idx *TFRecordIndex) error {
+	f, err := ioutil.TempFile(filepath.Dir(indexPath), filepath.Base(indexPath)+".")
+	if err != nil {
+		return errors.Wrapf(err, "cannot create %v", indexPath)
+	}
+	w := bufio.NewWriter(f)
+	for _, entry := range idx.Entries {
+		fmt.Fprintf(w, "%d\t%s\t%s\n", entry.Offset, entry.SourceID, entry.FileName)
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return errors.Wrapf(err, "cannot write %v", indexPath)
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return errors.Wrapf(err, "cannot write %v", indexPath)
+	}
+	if err := os.Rename(f.Name(), indexPath); err != nil {
+		os.Remove(f.Name())
+		return errors.Wrapf(err, "cannot rename %v", indexPath)
+	}
+	return nil
+}
@@ -3,8 +3,11 @@ package reader
 import (
 	context "context"
 	"image/png"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/GeertJohan/go-sourcepath"
@@ -41,3 +44,64 @@ func TestTFRecord(t *testing.T) {
 	err = png.Encode(out, rec.Image.ToRGBAImage())
 	assert.NoError(t, err)
 }
+
+func TestTFRecordIndex(t *testing.T) {
+	ctx := context.Background()
+
+	reader, err := NewTFRecordReader(filepath.Join(fixturesPath, "cifar10_validation.tfrecord"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, reader)
+
+	defer reader.Close()
+
+	idx, err := BuildTFRecordIndex(ctx, filepath.Join(fixturesPath, "cifar10_validation.tfrecord"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, idx.Entries)
+	assert.Equal(t, int64(0), idx.Entries[0].Offset)
+
+	first, err := reader.NextRecord(ctx)
+	assert.NoError(t, err)
+	second, err := reader.NextRecord(ctx)
+	assert.NoError(t, err)
+
+	rec, err := reader.ReadRecordAt(ctx, idx.Entries[1].Offset)
+	assert.NoError(t, err)
+	assert.Equal(t, second, rec)
+
+	rec, err = reader.ReadRecordAt(ctx, idx.Entries[0].Offset)
+	assert.NoError(t, err)
+	assert.Equal(t, first, rec)
+}
+
+func TestWriteTFRecordIndexConcurrent(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	indexPath := TFRecordIndexPath(filepath.Join(dir, "val.tfrecord"))
+	wg := sync.WaitGroup{}
+	for ii := 0; ii < 8; ii++ {
+		entries := make([]TFRecordIndexEntry, 1000)
+		for jj := range entries {
+			entries[jj] = TFRecordIndexEntry{Offset: int64(jj), SourceID: strconv.Itoa(ii), FileName: strconv.Itoa(jj)}
+		}
+		wg.Add(1)
+		go func(idx *TFRecordIndex) {
+			defer wg.Done()
+			assert.NoError(t, writeTFRecordIndex(indexPath, idx))
+		}(newTFRecordIndex(entries))
+	}
+	wg.Wait()
+
+	// the index is one of the written indices rather than an interleaving of them
+	idx, err := readTFRecordIndex(indexPath)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1000, idx.Len()) {
+		for _, entry := range idx.Entries {
+			assert.Equal(t, idx.Entries[0].SourceID, entry.SourceID)
+		}
+	}
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+}
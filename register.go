@@ -1,10 +1,11 @@
 package dldataset
 
 import (
-	"errors"
 	"path"
+	"sort"
 	"strings"
 
+	"github.com/pkg/errors"
 	"golang.org/x/sync/syncmap"
 )
 
@@ -20,14 +21,14 @@ func Get(category, name string) (Dataset, error) {
 		log.WithField("category", category).
 			WithField("name", name).
 			Warn("cannot find dataset")
-		return nil, errors.New("cannot find dataset")
+		return nil, errors.Wrapf(ErrNotFound, "cannot find dataset %s", key)
 	}
 	dataset, ok := val.(Dataset)
 	if !ok {
 		log.WithField("category", category).
 			WithField("name", name).
 			Warn("invalid dataset")
-		return nil, errors.New("invalid dataset")
+		return nil, errors.Errorf("invalid dataset %s", key)
 	}
 	return dataset, nil
 }
@@ -51,3 +52,27 @@ func Datasets() []string {
 	})
 	return names
 }
+
+// GetInfo ...
+func GetInfo(category, name string) (DatasetInfo, error) {
+	dataset, err := Get(category, name)
+	if err != nil {
+		return DatasetInfo{}, err
+	}
+	return dataset.Info(), nil
+}
+
+// DatasetInfos returns the info of every registered dataset ordered by canonical name
+func DatasetInfos() []DatasetInfo {
+	infos := []DatasetInfo{}
+	datasets.Range(func(_, val interface{}) bool {
+		if dataset, ok := val.(Dataset); ok {
+			infos = append(infos, dataset.Info())
+		}
+		return true
+	})
+	sort.Slice(infos, func(ii, jj int) bool {
+		return infos[ii].CanonicalName < infos[jj].CanonicalName
+	})
+	return infos
+}
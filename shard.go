@@ -0,0 +1,151 @@
+package dldataset
+
+import (
+	"io"
+
+	context "context"
+
+	"github.com/pkg/errors"
+)
+
+// shardDataset is a view of a contiguous range of a dataset's records
+type shardDataset struct {
+	Dataset
+	index  int
+	count  int
+	start  int
+	end    int
+	cursor int
+	ready  bool
+	names  map[string]bool
+}
+
+// Shard returns a view of the dataset that contains the index-th of count disjoint
+// partitions. The partitions are contiguous ranges of the dataset's Next (and List)
+// order, so the view seeks directly to the start of its range instead of reading
+// and discarding the records of the other partitions.
+func Shard(dataset Dataset, index, count int) (Dataset, error) {
+	if count <= 0 {
+		return nil, errors.Errorf("the shard count %d must be positive", count)
+	}
+	if index < 0 || index >= count {
+		return nil, errors.Errorf("the shard index %d is out of range %d", index, count)
+	}
+	return &shardDataset{
+		Dataset: dataset,
+		index:   index,
+		count:   count,
+	}, nil
+}
+
+func shardRange(length, index, count int) (int, int) {
+	return index * length / count, (index + 1) * length / count
+}
+
+func (d *shardDataset) init(ctx context.Context) error {
+	if d.ready {
+		return nil
+	}
+	length, err := d.Dataset.Len(ctx)
+	if err != nil {
+		return err
+	}
+	d.start, d.end = shardRange(length, d.index, d.count)
+	if err := d.Dataset.Seek(ctx, d.start); err != nil {
+		return err
+	}
+	d.cursor = d.start
+	d.ready = true
+	return nil
+}
+
+// New ...
+func (d *shardDataset) New(ctx context.Context) (Dataset, error) {
+	dataset, err := d.Dataset.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Shard(dataset, d.index, d.count)
+}
+
+// Load ...
+func (d *shardDataset) Load(ctx context.Context) error {
+	if err := d.Dataset.Load(ctx); err != nil {
+		return err
+	}
+	d.ready = false
+	return d.init(ctx)
+}
+
+// List ...
+func (d *shardDataset) List(ctx context.Context, opts ...ListOption) ([]string, error) {
+	names, err := d.Dataset.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	start, end := shardRange(len(names), d.index, d.count)
+	return ApplyListOptions(names[start:end], opts...)
+}
+
+// Get ...
+func (d *shardDataset) Get(ctx context.Context, name string) (LabeledData, error) {
+	if d.names == nil {
+		names, err := d.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		d.names = newNameSet(names)
+	}
+	if !d.names[name] {
+		return nil, errors.Wrapf(ErrNotFound, "unable to find %s in shard %d of %d of the %s dataset", name, d.index, d.count, d.CanonicalName())
+	}
+	return d.Dataset.Get(ctx, name)
+}
+
+// Next ...
+func (d *shardDataset) Next(ctx context.Context) (LabeledData, error) {
+	if err := d.init(ctx); err != nil {
+		return nil, err
+	}
+	if d.cursor >= d.end {
+		return nil, io.EOF
+	}
+	data, err := d.Dataset.Next(ctx)
+	if IsEOF(err) {
+		return nil, err
+	}
+	// the underlying dataset moves past a record even if it fails to decode it
+	d.cursor++
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Len ...
+func (d *shardDataset) Len(ctx context.Context) (int, error) {
+	if err := d.init(ctx); err != nil {
+		return 0, err
+	}
+	return d.end - d.start, nil
+}
+
+// Reset ...
+func (d *shardDataset) Reset(ctx context.Context) error {
+	return d.Seek(ctx, 0)
+}
+
+// Seek ...
+func (d *shardDataset) Seek(ctx context.Context, index int) error {
+	if err := d.init(ctx); err != nil {
+		return err
+	}
+	if index < 0 || index > d.end-d.start {
+		return errors.Errorf("the index %d is out of range %d", index, d.end-d.start)
+	}
+	if err := d.Dataset.Seek(ctx, d.start+index); err != nil {
+		return err
+	}
+	d.cursor = d.start + index
+	return nil
+}
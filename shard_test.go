@@ -0,0 +1,58 @@
+package dldataset
+
+import (
+	"io"
+	"testing"
+
+	context "context"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShard(t *testing.T) {
+	ctx := context.Background()
+
+	const count = 3
+	seen := []string{}
+	for index := 0; index < count; index++ {
+		shard, err := Shard(newTestDataset(10), index, count)
+		assert.NoError(t, err)
+
+		names, err := shard.List(ctx)
+		assert.NoError(t, err)
+
+		length, err := shard.Len(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, len(names), length)
+
+		for _, name := range names {
+			data, err := shard.Next(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, name, data.Label())
+
+			_, err = shard.Get(ctx, name)
+			assert.NoError(t, err)
+		}
+		_, err = shard.Next(ctx)
+		assert.Equal(t, io.EOF, err)
+
+		err = shard.Seek(ctx, 1)
+		assert.NoError(t, err)
+		data, err := shard.Next(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, names[1], data.Label())
+
+		seen = append(seen, names...)
+	}
+
+	all, _ := newTestDataset(10).List(ctx)
+	assert.Equal(t, all, seen)
+
+	shard, err := Shard(newTestDataset(10), 0, count)
+	assert.NoError(t, err)
+	_, err = shard.Get(ctx, "9")
+	assert.Error(t, err)
+
+	_, err = Shard(newTestDataset(10), count, count)
+	assert.Error(t, err)
+}
@@ -0,0 +1,187 @@
+package dldataset
+
+import (
+	"io"
+	"math/rand"
+
+	context "context"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultShuffleBufferSize is the size of the shuffle buffer used for datasets
+// that can only be read with Next
+const DefaultShuffleBufferSize = 1024
+
+// ShuffleOption ...
+type ShuffleOption func(*shuffleDataset)
+
+// ShuffleBufferSize sets the number of records buffered when shuffling a dataset
+// that can only be read with Next
+func ShuffleBufferSize(n int) ShuffleOption {
+	return func(d *shuffleDataset) {
+		d.bufferSize = n
+	}
+}
+
+// shuffleDataset returns the records of a dataset in a random order determined by the seed.
+// If the dataset supports List and Get, then Next walks a full permutation of the names.
+// Otherwise records are drawn at random from a bounded buffer filled by the dataset's Next.
+type shuffleDataset struct {
+	Dataset
+	seed       int64
+	bufferSize int
+	rand       *rand.Rand
+	names      []string
+	cursor     int
+	buffer     []LabeledData
+	exhausted  bool
+	ready      bool
+}
+
+// Shuffle returns a view of the dataset whose Next returns the records in a random
+// order. The same seed always gives the same order.
+func Shuffle(dataset Dataset, seed int64, opts ...ShuffleOption) (Dataset, error) {
+	d := &shuffleDataset{
+		Dataset:    dataset,
+		seed:       seed,
+		bufferSize: DefaultShuffleBufferSize,
+	}
+	for _, o := range opts {
+		o(d)
+	}
+	if d.bufferSize <= 0 {
+		return nil, errors.Errorf("the shuffle buffer size %d must be positive", d.bufferSize)
+	}
+	return d, nil
+}
+
+func (d *shuffleDataset) init(ctx context.Context) error {
+	if d.ready {
+		return nil
+	}
+	d.rand = rand.New(rand.NewSource(d.seed))
+	d.cursor = 0
+	d.buffer = nil
+	d.exhausted = false
+
+	// only a dataset that cannot list its names is read from the shuffle buffer, other
+	// errors such as a missing download are returned rather than hidden by the fallback
+	names, err := d.Dataset.List(ctx)
+	if err != nil && !IsNotSupported(err) {
+		return err
+	}
+	if err == nil && len(names) != 0 {
+		perm := d.rand.Perm(len(names))
+		d.names = make([]string, len(names))
+		for ii, jj := range perm {
+			d.names[ii] = names[jj]
+		}
+	} else {
+		d.names = nil
+		if err := d.Dataset.Reset(ctx); err != nil {
+			return err
+		}
+	}
+	d.ready = true
+	return nil
+}
+
+// New ...
+func (d *shuffleDataset) New(ctx context.Context) (Dataset, error) {
+	dataset, err := d.Dataset.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Shuffle(dataset, d.seed, ShuffleBufferSize(d.bufferSize))
+}
+
+// Load ...
+func (d *shuffleDataset) Load(ctx context.Context) error {
+	if err := d.Dataset.Load(ctx); err != nil {
+		return err
+	}
+	d.ready = false
+	return nil
+}
+
+// List returns the names in shuffled order
+func (d *shuffleDataset) List(ctx context.Context, opts ...ListOption) ([]string, error) {
+	if err := d.init(ctx); err != nil {
+		return nil, err
+	}
+	if d.names == nil {
+		return d.Dataset.List(ctx, opts...)
+	}
+	return ApplyListOptions(d.names, opts...)
+}
+
+// Next ...
+func (d *shuffleDataset) Next(ctx context.Context) (LabeledData, error) {
+	if err := d.init(ctx); err != nil {
+		return nil, err
+	}
+	if d.names != nil {
+		if d.cursor >= len(d.names) {
+			return nil, io.EOF
+		}
+		name := d.names[d.cursor]
+		d.cursor++
+		return d.Dataset.Get(ctx, name)
+	}
+
+	for !d.exhausted && len(d.buffer) < d.bufferSize {
+		data, err := d.Dataset.Next(ctx)
+		if IsEOF(err) {
+			d.exhausted = true
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		d.buffer = append(d.buffer, data)
+	}
+	if len(d.buffer) == 0 {
+		return nil, io.EOF
+	}
+	ii := d.rand.Intn(len(d.buffer))
+	last := len(d.buffer) - 1
+	data := d.buffer[ii]
+	d.buffer[ii] = d.buffer[last]
+	d.buffer = d.buffer[:last]
+	d.cursor++
+	return data, nil
+}
+
+// Reset restarts the shuffled order from the beginning
+func (d *shuffleDataset) Reset(ctx context.Context) error {
+	d.ready = false
+	return d.init(ctx)
+}
+
+// Seek positions Next at the index within the shuffled order. Datasets that can
+// only be read with Next are replayed from the beginning.
+func (d *shuffleDataset) Seek(ctx context.Context, index int) error {
+	if index < 0 {
+		return errors.Errorf("the index %d is out of range", index)
+	}
+	if err := d.Reset(ctx); err != nil {
+		return err
+	}
+	if d.names != nil {
+		if index > len(d.names) {
+			return errors.Errorf("the index %d is out of range %d", index, len(d.names))
+		}
+		d.cursor = index
+		return nil
+	}
+	for d.cursor < index {
+		if _, err := d.Next(ctx); err != nil {
+			if IsEOF(err) {
+				return errors.Errorf("the index %d is out of range %d", index, d.cursor)
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package dldataset
+
+import (
+	"sort"
+	"testing"
+
+	context "context"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShuffle(t *testing.T) {
+	ctx := context.Background()
+
+	datasets := map[string]func() Dataset{
+		"list":   func() Dataset { return newTestDataset(100) },
+		"stream": func() Dataset { return testStreamDataset{newTestDataset(100)} },
+	}
+
+	for name, newDataset := range datasets {
+		all, err := readAll(ctx, newDataset())
+		assert.NoError(t, err, name)
+
+		shuffled, err := Shuffle(newDataset(), 42, ShuffleBufferSize(10))
+		assert.NoError(t, err, name)
+		first, err := readAll(ctx, shuffled)
+		assert.NoError(t, err, name)
+		assert.NotEqual(t, all, first, name)
+
+		err = shuffled.Reset(ctx)
+		assert.NoError(t, err, name)
+		second, err := readAll(ctx, shuffled)
+		assert.NoError(t, err, name)
+		assert.Equal(t, first, second, name)
+
+		err = shuffled.Seek(ctx, 50)
+		assert.NoError(t, err, name)
+		tail, err := readAll(ctx, shuffled)
+		assert.NoError(t, err, name)
+		assert.Equal(t, first[50:], tail, name)
+
+		other, err := Shuffle(newDataset(), 43, ShuffleBufferSize(10))
+		assert.NoError(t, err, name)
+		third, err := readAll(ctx, other)
+		assert.NoError(t, err, name)
+		assert.NotEqual(t, first, third, name)
+
+		sort.Strings(all)
+		sort.Strings(first)
+		assert.Equal(t, all, first, name)
+	}
+}
+
+func TestShuffleListError(t *testing.T) {
+	ctx := context.Background()
+
+	shuffled, err := Shuffle(testListErrorDataset{newTestDataset(10)}, 42)
+	assert.NoError(t, err)
+	_, err = shuffled.Next(ctx)
+	assert.True(t, IsNotDownloaded(err))
+	_, err = shuffled.List(ctx)
+	assert.True(t, IsNotDownloaded(err))
+}
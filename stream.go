@@ -0,0 +1,77 @@
+package dldataset
+
+import (
+	"io"
+
+	context "context"
+
+	"github.com/pkg/errors"
+)
+
+// StreamOption ...
+type StreamOption func(*streamOptions)
+
+type streamOptions struct {
+	bufferSize int
+}
+
+// StreamBufferSize sets the number of records read ahead of the receiver
+func StreamBufferSize(n int) StreamOption {
+	return func(o *streamOptions) {
+		o.bufferSize = n
+	}
+}
+
+// Stream reads the dataset's Next on a background goroutine and sends the records on
+// the returned channel. The channel is closed once the dataset is exhausted, Next fails,
+// or the context is cancelled. The returned function waits for the channel to be closed
+// and returns the error that stopped the stream, or nil if the dataset was exhausted.
+// Callers that stop receiving early must cancel the context to release the goroutine.
+func Stream(ctx context.Context, dataset Dataset, opts ...StreamOption) (<-chan LabeledData, func() error) {
+	options := streamOptions{}
+	for _, o := range opts {
+		o(&options)
+	}
+	if options.bufferSize < 0 {
+		options.bufferSize = 0
+	}
+
+	records := make(chan LabeledData, options.bufferSize)
+	done := make(chan struct{})
+	var err error
+
+	go func() {
+		defer close(done)
+		defer close(records)
+		for {
+			if ctx.Err() != nil {
+				err = ctx.Err()
+				return
+			}
+			data, nextErr := dataset.Next(ctx)
+			if IsEOF(nextErr) {
+				return
+			}
+			if nextErr != nil {
+				err = nextErr
+				return
+			}
+			select {
+			case records <- data:
+			case <-ctx.Done():
+				err = ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return records, func() error {
+		<-done
+		return err
+	}
+}
+
+// IsEOF reports whether the error, or the error it wraps, is io.EOF
+func IsEOF(err error) bool {
+	return err != nil && errors.Cause(err) == io.EOF
+}
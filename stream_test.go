@@ -0,0 +1,42 @@
+package dldataset
+
+import (
+	"io"
+	"testing"
+
+	context "context"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream(t *testing.T) {
+	ctx := context.Background()
+
+	records, errFn := Stream(ctx, newTestDataset(5), StreamBufferSize(2))
+	res := []string{}
+	for data := range records {
+		res = append(res, data.Label())
+	}
+	assert.NoError(t, errFn())
+	assert.Equal(t, []string{"0", "1", "2", "3", "4"}, res)
+}
+
+func TestStreamCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	records, errFn := Stream(ctx, Repeat(newTestDataset(5), 0))
+	data := <-records
+	assert.Equal(t, "0", data.Label())
+	cancel()
+
+	for range records {
+	}
+	assert.Equal(t, context.Canceled, errFn())
+}
+
+func TestIsEOF(t *testing.T) {
+	assert.True(t, IsEOF(errors.Wrap(io.EOF, "failed to read record")))
+	assert.False(t, IsEOF(errors.New("failed to read record")))
+	assert.False(t, IsEOF(nil))
+}
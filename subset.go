@@ -0,0 +1,112 @@
+package dldataset
+
+import (
+	"io"
+	"path"
+	"strings"
+
+	context "context"
+
+	"github.com/pkg/errors"
+)
+
+// subsetDataset is a named view of the records of a dataset whose names are in a fixed list
+type subsetDataset struct {
+	Dataset
+	name   string
+	ids    []string
+	set    map[string]bool
+	cursor int
+}
+
+// Subset returns a dataset named name that contains the records of the dataset with the given
+// ids, in the order of the ids. Records are read with the dataset's Get, so the ids can be any
+// key that Get accepts. An id that the dataset does not contain is reported as an error by the
+// Get or Next that reaches it.
+func Subset(dataset Dataset, name string, ids []string) Dataset {
+	return &subsetDataset{
+		Dataset: dataset,
+		name:    name,
+		ids:     ids,
+		set:     newNameSet(ids),
+	}
+}
+
+// New ...
+func (d *subsetDataset) New(ctx context.Context) (Dataset, error) {
+	dataset, err := d.Dataset.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Subset(dataset, d.name, d.ids), nil
+}
+
+// Name ...
+func (d *subsetDataset) Name() string {
+	return d.name
+}
+
+// CanonicalName ...
+func (d *subsetDataset) CanonicalName() string {
+	category := strings.ToLower(d.Category())
+	name := strings.ToLower(d.Name())
+	return path.Join(category, name)
+}
+
+// Info ...
+func (d *subsetDataset) Info() DatasetInfo {
+	info := d.Dataset.Info()
+	info.CanonicalName = d.CanonicalName()
+	info.Name = d.Name()
+	split := "subset"
+	if len(info.Splits) == 1 {
+		for key := range info.Splits {
+			split = key
+		}
+	}
+	info.Splits = map[string]int{split: len(d.ids)}
+	return info
+}
+
+// List ...
+func (d *subsetDataset) List(ctx context.Context, opts ...ListOption) ([]string, error) {
+	return ApplyListOptions(d.ids, opts...)
+}
+
+// Get ...
+func (d *subsetDataset) Get(ctx context.Context, name string) (LabeledData, error) {
+	if !d.set[name] {
+		return nil, errors.Wrapf(ErrNotFound, "unable to find %s in the %s dataset", name, d.CanonicalName())
+	}
+	return d.Dataset.Get(ctx, name)
+}
+
+// Next ...
+func (d *subsetDataset) Next(ctx context.Context) (LabeledData, error) {
+	if d.cursor >= len(d.ids) {
+		return nil, io.EOF
+	}
+	name := d.ids[d.cursor]
+	d.cursor++
+	return d.Dataset.Get(ctx, name)
+}
+
+// Len ...
+func (d *subsetDataset) Len(ctx context.Context) (int, error) {
+	return len(d.ids), nil
+}
+
+// Reset ...
+func (d *subsetDataset) Reset(ctx context.Context) error {
+	d.cursor = 0
+	return nil
+}
+
+// Seek ...
+func (d *subsetDataset) Seek(ctx context.Context, index int) error {
+	if index < 0 || index > len(d.ids) {
+		return errors.Errorf("the index %d is out of range %d", index, len(d.ids))
+	}
+	d.cursor = index
+	return nil
+}
@@ -0,0 +1,33 @@
+package dldataset
+
+import (
+	"testing"
+
+	context "context"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubset(t *testing.T) {
+	ctx := context.Background()
+
+	ds := Subset(newTestDataset(10), "subset", []string{"7", "2", "5"})
+	assert.Equal(t, "test/subset", ds.CanonicalName())
+	assert.Equal(t, 3, ds.Info().NumExamples())
+
+	res, err := readAll(ctx, ds)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"7", "2", "5"}, res)
+
+	assert.NoError(t, ds.Seek(ctx, 2))
+	res, err = readAll(ctx, ds)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"5"}, res)
+
+	_, err = ds.Get(ctx, "3")
+	assert.Error(t, err)
+
+	missing := Subset(newTestDataset(10), "missing", []string{"1", "42"})
+	_, err = readAll(ctx, missing)
+	assert.Error(t, err)
+}
@@ -0,0 +1,515 @@
+package dldataset
+
+import (
+	"io"
+
+	context "context"
+
+	"github.com/pkg/errors"
+)
+
+// MapFunc transforms a record
+type MapFunc func(ctx context.Context, data LabeledData) (LabeledData, error)
+
+// FilterFunc reports whether a record should be kept
+type FilterFunc func(ctx context.Context, data LabeledData) (bool, error)
+
+type mapDataset struct {
+	Dataset
+	fn MapFunc
+}
+
+// Map returns a view of the dataset where every record returned by Next and Get is transformed by fn
+func Map(dataset Dataset, fn MapFunc) Dataset {
+	return &mapDataset{
+		Dataset: dataset,
+		fn:      fn,
+	}
+}
+
+// New ...
+func (d *mapDataset) New(ctx context.Context) (Dataset, error) {
+	dataset, err := d.Dataset.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Map(dataset, d.fn), nil
+}
+
+// Get ...
+func (d *mapDataset) Get(ctx context.Context, name string) (LabeledData, error) {
+	data, err := d.Dataset.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return d.fn(ctx, data)
+}
+
+// Next ...
+func (d *mapDataset) Next(ctx context.Context) (LabeledData, error) {
+	data, err := d.Dataset.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.fn(ctx, data)
+}
+
+type filterDataset struct {
+	Dataset
+	fn     FilterFunc
+	names  []string
+	cursor int
+}
+
+// Filter returns a view of the dataset that only contains the records for which fn returns true.
+// List, Len and Seek have to evaluate fn on every record of the dataset.
+func Filter(dataset Dataset, fn FilterFunc) Dataset {
+	return &filterDataset{
+		Dataset: dataset,
+		fn:      fn,
+	}
+}
+
+// New ...
+func (d *filterDataset) New(ctx context.Context) (Dataset, error) {
+	dataset, err := d.Dataset.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Filter(dataset, d.fn), nil
+}
+
+// List ...
+func (d *filterDataset) List(ctx context.Context, opts ...ListOption) ([]string, error) {
+	if d.names != nil {
+		return ApplyListOptions(d.names, opts...)
+	}
+	names, err := d.Dataset.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filtered := []string{}
+	for _, name := range names {
+		data, err := d.Dataset.Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := d.fn(ctx, data)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			filtered = append(filtered, name)
+		}
+	}
+	d.names = filtered
+	return ApplyListOptions(filtered, opts...)
+}
+
+// Get ...
+func (d *filterDataset) Get(ctx context.Context, name string) (LabeledData, error) {
+	data, err := d.Dataset.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := d.fn(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Wrapf(ErrNotFound, "unable to find %s in the filtered %s dataset", name, d.CanonicalName())
+	}
+	return data, nil
+}
+
+// Next ...
+func (d *filterDataset) Next(ctx context.Context) (LabeledData, error) {
+	for {
+		data, err := d.Dataset.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ok, err := d.fn(ctx, data)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			d.cursor++
+			return data, nil
+		}
+	}
+}
+
+// Len ...
+func (d *filterDataset) Len(ctx context.Context) (int, error) {
+	names, err := d.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(names), nil
+}
+
+// Reset ...
+func (d *filterDataset) Reset(ctx context.Context) error {
+	if err := d.Dataset.Reset(ctx); err != nil {
+		return err
+	}
+	d.cursor = 0
+	return nil
+}
+
+// Seek replays the dataset from the beginning until index records have been kept
+func (d *filterDataset) Seek(ctx context.Context, index int) error {
+	if index < 0 {
+		return errors.Errorf("the index %d is out of range", index)
+	}
+	if err := d.Reset(ctx); err != nil {
+		return err
+	}
+	for d.cursor < index {
+		if _, err := d.Next(ctx); err != nil {
+			if IsEOF(err) {
+				return errors.Errorf("the index %d is out of range %d", index, d.cursor)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+type takeDataset struct {
+	Dataset
+	count  int
+	cursor int
+	names  map[string]bool
+}
+
+// Take returns a view of the first count records of the dataset
+func Take(dataset Dataset, count int) Dataset {
+	if count < 0 {
+		count = 0
+	}
+	return &takeDataset{
+		Dataset: dataset,
+		count:   count,
+	}
+}
+
+// New ...
+func (d *takeDataset) New(ctx context.Context) (Dataset, error) {
+	dataset, err := d.Dataset.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Take(dataset, d.count), nil
+}
+
+// List ...
+func (d *takeDataset) List(ctx context.Context, opts ...ListOption) ([]string, error) {
+	names, err := d.Dataset.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) > d.count {
+		names = names[:d.count]
+	}
+	return ApplyListOptions(names, opts...)
+}
+
+// Get ...
+func (d *takeDataset) Get(ctx context.Context, name string) (LabeledData, error) {
+	if d.names == nil {
+		names, err := d.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		d.names = newNameSet(names)
+	}
+	if !d.names[name] {
+		return nil, errors.Wrapf(ErrNotFound, "unable to find %s in the first %d records of the %s dataset", name, d.count, d.CanonicalName())
+	}
+	return d.Dataset.Get(ctx, name)
+}
+
+// Next ...
+func (d *takeDataset) Next(ctx context.Context) (LabeledData, error) {
+	if d.cursor >= d.count {
+		return nil, io.EOF
+	}
+	data, err := d.Dataset.Next(ctx)
+	if IsEOF(err) {
+		return nil, err
+	}
+	d.cursor++
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Len ...
+func (d *takeDataset) Len(ctx context.Context) (int, error) {
+	length, err := d.Dataset.Len(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if length > d.count {
+		return d.count, nil
+	}
+	return length, nil
+}
+
+// Reset ...
+func (d *takeDataset) Reset(ctx context.Context) error {
+	if err := d.Dataset.Reset(ctx); err != nil {
+		return err
+	}
+	d.cursor = 0
+	return nil
+}
+
+// Seek ...
+func (d *takeDataset) Seek(ctx context.Context, index int) error {
+	if index < 0 || index > d.count {
+		return errors.Errorf("the index %d is out of range %d", index, d.count)
+	}
+	if err := d.Dataset.Seek(ctx, index); err != nil {
+		return err
+	}
+	d.cursor = index
+	return nil
+}
+
+type skipDataset struct {
+	Dataset
+	count int
+	ready bool
+	names map[string]bool
+}
+
+// Skip returns a view of the dataset without its first count records.
+// The skipped records are passed over with Seek rather than read.
+func Skip(dataset Dataset, count int) Dataset {
+	if count < 0 {
+		count = 0
+	}
+	return &skipDataset{
+		Dataset: dataset,
+		count:   count,
+	}
+}
+
+func (d *skipDataset) init(ctx context.Context) error {
+	if d.ready {
+		return nil
+	}
+	if err := d.seek(ctx, 0); err != nil {
+		return err
+	}
+	d.ready = true
+	return nil
+}
+
+func (d *skipDataset) seek(ctx context.Context, index int) error {
+	length, err := d.Dataset.Len(ctx)
+	if err != nil {
+		return err
+	}
+	offset := d.count + index
+	if offset > length {
+		offset = length
+	}
+	return d.Dataset.Seek(ctx, offset)
+}
+
+// New ...
+func (d *skipDataset) New(ctx context.Context) (Dataset, error) {
+	dataset, err := d.Dataset.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Skip(dataset, d.count), nil
+}
+
+// Load ...
+func (d *skipDataset) Load(ctx context.Context) error {
+	if err := d.Dataset.Load(ctx); err != nil {
+		return err
+	}
+	d.ready = false
+	return d.init(ctx)
+}
+
+// List ...
+func (d *skipDataset) List(ctx context.Context, opts ...ListOption) ([]string, error) {
+	names, err := d.Dataset.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) < d.count {
+		return []string{}, nil
+	}
+	return ApplyListOptions(names[d.count:], opts...)
+}
+
+// Get ...
+func (d *skipDataset) Get(ctx context.Context, name string) (LabeledData, error) {
+	if d.names == nil {
+		names, err := d.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		d.names = newNameSet(names)
+	}
+	if !d.names[name] {
+		return nil, errors.Wrapf(ErrNotFound, "unable to find %s after skipping %d records of the %s dataset", name, d.count, d.CanonicalName())
+	}
+	return d.Dataset.Get(ctx, name)
+}
+
+// Next ...
+func (d *skipDataset) Next(ctx context.Context) (LabeledData, error) {
+	if err := d.init(ctx); err != nil {
+		return nil, err
+	}
+	return d.Dataset.Next(ctx)
+}
+
+// Len ...
+func (d *skipDataset) Len(ctx context.Context) (int, error) {
+	length, err := d.Dataset.Len(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if length < d.count {
+		return 0, nil
+	}
+	return length - d.count, nil
+}
+
+// Reset ...
+func (d *skipDataset) Reset(ctx context.Context) error {
+	return d.Seek(ctx, 0)
+}
+
+// Seek ...
+func (d *skipDataset) Seek(ctx context.Context, index int) error {
+	if index < 0 {
+		return errors.Errorf("the index %d is out of range", index)
+	}
+	if err := d.seek(ctx, index); err != nil {
+		return err
+	}
+	d.ready = true
+	return nil
+}
+
+type repeatDataset struct {
+	Dataset
+	count int
+	epoch int
+}
+
+// Repeat returns a view of the dataset whose Next iterates over the dataset count times.
+// If count is not positive then the dataset is repeated indefinitely.
+func Repeat(dataset Dataset, count int) Dataset {
+	return &repeatDataset{
+		Dataset: dataset,
+		count:   count,
+	}
+}
+
+// New ...
+func (d *repeatDataset) New(ctx context.Context) (Dataset, error) {
+	dataset, err := d.Dataset.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return Repeat(dataset, d.count), nil
+}
+
+// Next ...
+func (d *repeatDataset) Next(ctx context.Context) (LabeledData, error) {
+	data, err := d.Dataset.Next(ctx)
+	if !IsEOF(err) {
+		return data, err
+	}
+	if d.count > 0 && d.epoch+1 >= d.count {
+		return nil, err
+	}
+	if err := d.Dataset.Reset(ctx); err != nil {
+		return nil, err
+	}
+	d.epoch++
+	// an empty dataset ends the repetition rather than looping forever
+	return d.Dataset.Next(ctx)
+}
+
+// Len ...
+func (d *repeatDataset) Len(ctx context.Context) (int, error) {
+	if d.count <= 0 {
+		return 0, errors.Wrapf(ErrNotSupported, "the length of the indefinitely repeated %s dataset is not known", d.CanonicalName())
+	}
+	length, err := d.Dataset.Len(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return d.count * length, nil
+}
+
+// List returns the names of the dataset repeated count times, in the order of Next. Get
+// accepts each of the names, since the repeated records are read from the same dataset.
+func (d *repeatDataset) List(ctx context.Context, opts ...ListOption) ([]string, error) {
+	if d.count <= 0 {
+		return nil, errors.Wrapf(ErrNotSupported, "the names of the indefinitely repeated %s dataset cannot be listed", d.CanonicalName())
+	}
+	names, err := d.Dataset.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	repeated := make([]string, 0, d.count*len(names))
+	for ii := 0; ii < d.count; ii++ {
+		repeated = append(repeated, names...)
+	}
+	return ApplyListOptions(repeated, opts...)
+}
+
+// Reset ...
+func (d *repeatDataset) Reset(ctx context.Context) error {
+	if err := d.Dataset.Reset(ctx); err != nil {
+		return err
+	}
+	d.epoch = 0
+	return nil
+}
+
+// Seek ...
+func (d *repeatDataset) Seek(ctx context.Context, index int) error {
+	length, err := d.Dataset.Len(ctx)
+	if err != nil {
+		return err
+	}
+	if index < 0 || (d.count > 0 && index > d.count*length) {
+		return errors.Errorf("the index %d is out of range %d", index, d.count*length)
+	}
+	if length == 0 {
+		return d.Reset(ctx)
+	}
+	epoch, offset := index/length, index%length
+	if d.count > 0 && epoch == d.count {
+		epoch, offset = d.count-1, length
+	}
+	if err := d.Dataset.Seek(ctx, offset); err != nil {
+		return err
+	}
+	d.epoch = epoch
+	return nil
+}
+
+func newNameSet(names []string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}
@@ -0,0 +1,115 @@
+package dldataset
+
+import (
+	"strconv"
+	"testing"
+
+	context "context"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(ctx context.Context, data LabeledData) (bool, error) {
+	ii, err := strconv.Atoi(data.Label())
+	return ii%2 == 0, err
+}
+
+func TestMap(t *testing.T) {
+	ctx := context.Background()
+
+	dataset := Map(newTestDataset(3), func(ctx context.Context, data LabeledData) (LabeledData, error) {
+		return testLabeledData{name: "x" + data.Label()}, nil
+	})
+	all, err := readAll(ctx, dataset)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"x0", "x1", "x2"}, all)
+
+	data, err := dataset.Get(ctx, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "x1", data.Label())
+}
+
+func TestFilter(t *testing.T) {
+	ctx := context.Background()
+
+	dataset := Filter(newTestDataset(7), isEven)
+	all, err := readAll(ctx, dataset)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0", "2", "4", "6"}, all)
+
+	names, err := dataset.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, all, names)
+
+	_, err = dataset.Get(ctx, "3")
+	assert.Error(t, err)
+
+	err = dataset.Seek(ctx, 2)
+	assert.NoError(t, err)
+	tail, err := readAll(ctx, dataset)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"4", "6"}, tail)
+}
+
+func TestTakeSkip(t *testing.T) {
+	ctx := context.Background()
+
+	take := Take(newTestDataset(5), 3)
+	all, err := readAll(ctx, take)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0", "1", "2"}, all)
+	length, err := take.Len(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, length)
+	_, err = take.Get(ctx, "3")
+	assert.Error(t, err)
+
+	skip := Skip(newTestDataset(5), 3)
+	all, err = readAll(ctx, skip)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3", "4"}, all)
+	names, err := skip.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, all, names)
+	_, err = skip.Get(ctx, "2")
+	assert.Error(t, err)
+
+	err = skip.Reset(ctx)
+	assert.NoError(t, err)
+	all, err = readAll(ctx, Take(skip, 1))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3"}, all)
+}
+
+func TestRepeat(t *testing.T) {
+	ctx := context.Background()
+
+	repeat := Repeat(newTestDataset(2), 3)
+	all, err := readAll(ctx, repeat)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0", "1", "0", "1", "0", "1"}, all)
+
+	length, err := repeat.Len(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, length)
+
+	names, err := repeat.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, all, names)
+	names, err = repeat.List(ctx, ListOffset(3), ListLimit(2))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "0"}, names)
+
+	err = repeat.Seek(ctx, 3)
+	assert.NoError(t, err)
+	all, err = readAll(ctx, repeat)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "0", "1"}, all)
+
+	all, err = readAll(ctx, Take(Repeat(newTestDataset(2), 0), 5))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0", "1", "0", "1", "0"}, all)
+
+	_, err = Repeat(newTestDataset(2), 0).List(ctx)
+	assert.True(t, IsNotSupported(err))
+}
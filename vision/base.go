@@ -1,7 +1,11 @@
 package vision
 
 import (
+	"path/filepath"
+
 	context "context"
+
+	"github.com/rai-project/dldataset"
 )
 
 type base struct {
@@ -13,3 +17,24 @@ type base struct {
 func (base) Category() string {
 	return "vision"
 }
+
+// datasetWorkingDir returns the directory of the files of the dataset, which is
+// <base working dir>/<canonical name> unless the dataset's config overrides it
+func datasetWorkingDir(baseWorkingDir, canonicalName string) string {
+	if dir := dldataset.Config.Dataset(canonicalName).WorkingDirectory; dir != "" {
+		return dir
+	}
+	return filepath.Join(baseWorkingDir, filepath.FromSlash(canonicalName))
+}
+
+// datasetFilePath returns the path of a file of the dataset in the working directory,
+// unless the dataset's config maps the file to another path
+func datasetFilePath(workingDir, canonicalName, fileName string) string {
+	if path, ok := dldataset.Config.Dataset(canonicalName).FilePath(fileName); ok {
+		if filepath.IsAbs(path) {
+			return path
+		}
+		return filepath.Join(workingDir, path)
+	}
+	return filepath.Join(workingDir, fileName)
+}
@@ -27,6 +27,11 @@ import (
 
 var cifar10 *CIFAR10
 
+const cifarCitation = "Alex Krizhevsky. Learning Multiple Layers of Features from Tiny Images. Technical Report, University of Toronto, 2009."
+
+// cifar10DiskSize is the number of bytes of cifar-10-binary.tar.gz
+const cifar10DiskSize = 170052171
+
 // CIFAR10 ...
 type CIFAR10 struct {
 	base
@@ -38,15 +43,19 @@ type CIFAR10 struct {
 	testFileNameList    map[string]string
 	labelFileName       string
 	labels              []string
+	classes             *dldataset.ClassMap
 	labelByteSize       int
 	pixelByteSize       int
 	imageDimensions     []int
 	data                map[string]CIFAR10LabeledImage
+	names               []string
+	cursor              int
 	isDownloaded        bool
 }
 
 // CIFAR10LabeledImage ...
 type CIFAR10LabeledImage struct {
+	name  string
 	label string
 	data  *types.RGBImage
 }
@@ -73,6 +82,11 @@ func (l CIFAR10LabeledImage) Data() (interface{}, error) {
 	return l.data, nil
 }
 
+// Metadata ...
+func (l CIFAR10LabeledImage) Metadata() dldataset.Metadata {
+	return imageMetadata(l.name, l.data)
+}
+
 // Name ...
 func (*CIFAR10) Name() string {
 	return "CIFAR10"
@@ -86,9 +100,43 @@ func (d *CIFAR10) CanonicalName() string {
 	return key
 }
 
-// New ...
+// Info ...
+func (d *CIFAR10) Info() dldataset.DatasetInfo {
+	return dldataset.DatasetInfo{
+		CanonicalName: d.CanonicalName(),
+		Category:      d.Category(),
+		Name:          d.Name(),
+		Task:          dldataset.ClassificationTask,
+		Splits: map[string]int{
+			"train": 50000,
+			"test":  10000,
+		},
+		NumClasses: 10,
+		ImageSize:  d.imageDimensions,
+		DiskSize:   cifar10DiskSize,
+		URLs:       []string{d.url},
+		Citation:   cifarCitation,
+	}
+}
+
+// New returns an instance of the dataset that does not share any read state with d
 func (d *CIFAR10) New(ctx context.Context) (dldataset.Dataset, error) {
-	return cifar10, nil
+	return &CIFAR10{
+		base: base{
+			ctx:            ctx,
+			baseWorkingDir: d.baseWorkingDir,
+		},
+		url:                 d.url,
+		fileName:            d.fileName,
+		extractedFolderName: d.extractedFolderName,
+		md5sum:              d.md5sum,
+		trainFileNameList:   d.trainFileNameList,
+		testFileNameList:    d.testFileNameList,
+		labelFileName:       d.labelFileName,
+		imageDimensions:     d.imageDimensions,
+		labelByteSize:       d.labelByteSize,
+		pixelByteSize:       d.pixelByteSize,
+	}, nil
 }
 
 func (d *CIFAR10) Load(ctx context.Context) error {
@@ -100,24 +148,67 @@ func (d *CIFAR10) Download(ctx context.Context) error {
 	if d.isDownloaded {
 		return nil
 	}
+	if err := dldataset.DownloadFiles(ctx, d.datasetFiles(), d.Verify, d.download); err != nil {
+		return err
+	}
+	d.isDownloaded = true
+	return nil
+}
+
+// download downloads the archive and extracts the files of the dataset
+func (d *CIFAR10) download(ctx context.Context, verifyErr error) error {
 	workingDir := d.workingDir()
-	downloadedFileName := filepath.Join(workingDir, d.fileName)
-	downloadedFileName, ifDownload, err := downloadmanager.DownloadFile(d.url, downloadedFileName, downloadmanager.Context(ctx), downloadmanager.MD5Sum(d.md5sum))
+	archiveOutputDir := filepath.Join(workingDir, d.extractedFolderName)
+	downloadedFileName := d.filePath(d.fileName)
+	downloadedFileName, ifDownload, err := downloadFile(ctx, d.CanonicalName(), d.fileName, d.url, downloadedFileName, dldataset.Checksum{MD5: d.md5sum})
 	if err != nil {
 		return err
 	}
-	if ifDownload {
+	// the archive is extracted again if it was already downloaded but the files are corrupt
+	if ifDownload || !com.IsDir(archiveOutputDir) {
 		if err := downloadmanager.Unarchive(workingDir, downloadedFileName); err != nil {
 			return err
 		}
 	}
-	if err := d.move(ctx); err != nil {
-		return err
+	if !dldataset.Config.Dataset(d.CanonicalName()).KeepExtracted {
+		defer os.RemoveAll(archiveOutputDir)
 	}
-	archiveOutputDir := filepath.Join(workingDir, d.extractedFolderName)
-	defer os.RemoveAll(archiveOutputDir)
+	return d.move(ctx)
+}
 
-	return nil
+// datasetFiles describes the extracted files, which are recorded with the url of the
+// archive they were extracted from
+func (d *CIFAR10) datasetFiles() dldataset.DatasetFiles {
+	url := dldataset.MirrorURL(d.CanonicalName(), d.fileName, d.url)
+	urls := map[string]string{}
+	for _, fileName := range []string{d.labelFileName} {
+		urls[fileName] = url
+	}
+	for fileName := range d.trainFileNameList {
+		urls[fileName] = url
+	}
+	for fileName := range d.testFileNameList {
+		urls[fileName] = url
+	}
+	return newDatasetFiles(d.workingDir(), d.CanonicalName(), urls)
+}
+
+// Verify checks the extracted files against the checksums of the manifest, or against
+// their md5 sums if they were not recorded in a manifest
+func (d *CIFAR10) Verify(ctx context.Context) error {
+	if ok, err := d.datasetFiles().VerifyManifest(); ok || err != nil {
+		return err
+	}
+	checksums := map[string]dldataset.Checksum{
+		d.labelFileName: dldataset.Checksum{},
+	}
+	for fileName, md5 := range d.trainFileNameList {
+		checksums[fileName] = dldataset.Checksum{MD5: md5}
+	}
+	for fileName, md5 := range d.testFileNameList {
+		checksums[fileName] = dldataset.Checksum{MD5: md5}
+	}
+	return verifyFiles(d.workingDir(), d.CanonicalName(), checksums)
 }
 
 func (d *CIFAR10) move(ctx context.Context) error {
@@ -133,41 +224,37 @@ func (d *CIFAR10) move(ctx context.Context) error {
 	for fileName, md5 := range filesHashes {
 		filePath := filepath.Join(archiveOutputDir, fileName)
 		if !com.IsFile(filePath) {
-			return errors.Errorf("the file %s for %s was not found in the extracted directory", fileName, d.CanonicalName())
+			return errors.Wrapf(dldataset.ErrNotDownloaded, "the file %s for %s was not found in the extracted directory", fileName, d.CanonicalName())
 		}
-		newPath := filepath.Join(workingDir, fileName)
-		if err := os.Rename(filePath, newPath); err != nil {
-			return errors.Wrapf(err, "cannot move the file %s to %s", filePath, newPath)
+		newPath := d.filePath(fileName)
+		if err := moveExtractedFile(d.CanonicalName(), filePath, newPath); err != nil {
+			return err
 		}
 		ok, err := utils.MD5Sum.CheckFile(newPath, md5)
 		if err != nil {
 			return err
 		}
 		if !ok {
-			return errors.Wrapf(err, "the md5 sum for %s did not match expected %s", newPath, md5)
+			return errors.Wrapf(dldataset.ErrChecksumMismatch, "the md5 sum for %s did not match expected %s", newPath, md5)
 		}
 	}
 	labelFilePath := filepath.Join(archiveOutputDir, d.labelFileName)
 	if !com.IsFile(labelFilePath) {
-		return errors.Errorf("the file %s for %s was not found in the extracted directory", labelFilePath, d.CanonicalName())
+		return errors.Wrapf(dldataset.ErrNotDownloaded, "the file %s for %s was not found in the extracted directory", labelFilePath, d.CanonicalName())
 	}
-	newLabelFilePath := filepath.Join(workingDir, d.labelFileName)
-	if err := os.Rename(labelFilePath, newLabelFilePath); err != nil {
-		return errors.Wrapf(err, "cannot move the file %s to %s", labelFilePath, newLabelFilePath)
+	newLabelFilePath := d.filePath(d.labelFileName)
+	if err := moveExtractedFile(d.CanonicalName(), labelFilePath, newLabelFilePath); err != nil {
+		return err
 	}
 	return nil
 }
 
 // List ...
-func (d *CIFAR10) List(ctx context.Context) ([]string, error) {
+func (d *CIFAR10) List(ctx context.Context, opts ...dldataset.ListOption) ([]string, error) {
 	if err := d.read(ctx); err != nil {
 		return nil, err
 	}
-	keys := []string{}
-	for key := range d.data {
-		keys = append(keys, key)
-	}
-	return keys, nil
+	return dldataset.ApplyListOptions(d.names, opts...)
 }
 
 // Get ...
@@ -177,13 +264,48 @@ func (d *CIFAR10) Get(ctx context.Context, name string) (dldataset.LabeledData,
 	}
 	data, ok := d.data[name]
 	if !ok {
-		return nil, errors.Errorf("unable to find %s in the %s dataset", name, d.CanonicalName())
+		return nil, errors.Wrapf(dldataset.ErrNotFound, "unable to find %s in the %s dataset", name, d.CanonicalName())
 	}
 	return data, nil
 }
 
+// Next ...
 func (d *CIFAR10) Next(ctx context.Context) (dldataset.LabeledData, error) {
-	return nil, errors.New("next iterator is not implemented for " + d.CanonicalName())
+	if err := d.read(ctx); err != nil {
+		return nil, err
+	}
+	if d.cursor >= len(d.names) {
+		return nil, io.EOF
+	}
+	data := d.data[d.names[d.cursor]]
+	d.cursor++
+	return data, nil
+}
+
+// Len ...
+func (d *CIFAR10) Len(ctx context.Context) (int, error) {
+	if err := d.read(ctx); err != nil {
+		return 0, err
+	}
+	return len(d.names), nil
+}
+
+// Reset ...
+func (d *CIFAR10) Reset(ctx context.Context) error {
+	d.cursor = 0
+	return nil
+}
+
+// Seek ...
+func (d *CIFAR10) Seek(ctx context.Context, index int) error {
+	if err := d.read(ctx); err != nil {
+		return err
+	}
+	if index < 0 || index > len(d.names) {
+		return errors.Errorf("the index %d is out of range %d", index, len(d.names))
+	}
+	d.cursor = index
+	return nil
 }
 
 func (d *CIFAR10) read(ctx context.Context) error {
@@ -201,12 +323,12 @@ func (d *CIFAR10) readData(ctx context.Context) error {
 		return nil
 	}
 
-	workingDir := d.workingDir()
 	data := map[string]CIFAR10LabeledImage{}
+	names := []string{}
 
 	read := func(offset int, class, fileName string) (int, error) {
 		idx := offset
-		filePath := filepath.Join(workingDir, fileName)
+		filePath := d.filePath(fileName)
 		f, err := os.Open(filePath)
 		if err != nil {
 			return idx, errors.Wrapf(err, "failed to open %s while performing md5 checksum", filePath)
@@ -216,18 +338,20 @@ func (d *CIFAR10) readData(ctx context.Context) error {
 		for {
 			entry, err := d.readEntry(ctx, f)
 			if err == io.EOF {
-				return 0, nil
+				return idx, nil
 			}
 			if err != nil {
 				return idx, errors.Wrapf(err, "failed reading entry for %s", filePath)
 			}
-			data[class+"/"+strconv.Itoa(idx)] = *entry
+			name := class + "/" + strconv.Itoa(idx)
+			entry.name = name
+			data[name] = *entry
+			names = append(names, name)
 			idx++
 		}
-		return idx, nil
 	}
 	idx := 0
-	for fileName := range d.trainFileNameList {
+	for _, fileName := range sortedKeys(d.trainFileNameList) {
 		newIdx, err := read(idx, "train", fileName)
 		if err != nil {
 			return err
@@ -235,7 +359,7 @@ func (d *CIFAR10) readData(ctx context.Context) error {
 		idx = newIdx
 	}
 	idx = 0
-	for fileName := range d.trainFileNameList {
+	for _, fileName := range sortedKeys(d.testFileNameList) {
 		newIdx, err := read(idx, "test", fileName)
 		if err != nil {
 			return err
@@ -244,12 +368,13 @@ func (d *CIFAR10) readData(ctx context.Context) error {
 	}
 
 	d.data = data
+	d.names = names
 
 	return nil
 }
 
 func (d *CIFAR10) readEntry(ctx context.Context, reader io.Reader) (*CIFAR10LabeledImage, error) {
-	var labelIdx int8
+	var labelIdx uint8
 	labelByteSize := int64(d.labelByteSize)
 	labelBytesReader := io.LimitReader(reader, labelByteSize)
 	err := binary.Read(labelBytesReader, binary.LittleEndian, &labelIdx)
@@ -257,10 +382,10 @@ func (d *CIFAR10) readEntry(ctx context.Context, reader io.Reader) (*CIFAR10Labe
 		return nil, err
 	}
 	if err != nil {
-		return nil, errors.New("unable to read label")
+		return nil, errors.Wrap(dldataset.ErrCorruptRecord, "unable to read label")
 	}
 	if int(labelIdx) >= len(d.labels) {
-		return nil, errors.Errorf("the label %v is out of range of %v", labelIdx, len(d.labels))
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "the label %v is out of range of %v", labelIdx, len(d.labels))
 	}
 
 	pixelByteSize := int64(d.pixelByteSize)
@@ -269,11 +394,11 @@ func (d *CIFAR10) readEntry(ctx context.Context, reader io.Reader) (*CIFAR10Labe
 	img := types.NewRGBImage(image.Rect(0, 0, d.imageDimensions[0], d.imageDimensions[1]))
 
 	err = binary.Read(pixelBytesReader, binary.LittleEndian, img.Pix)
-	if err == io.EOF {
-		return nil, err
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, errors.Wrap(dldataset.ErrCorruptRecord, "unable to read the image: the record is truncated")
 	}
 	if err != nil {
-		return nil, errors.New("unable to read label")
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "unable to read the image: %v", err)
 	}
 
 	return &CIFAR10LabeledImage{
@@ -282,15 +407,52 @@ func (d *CIFAR10) readEntry(ctx context.Context, reader io.Reader) (*CIFAR10Labe
 	}, nil
 }
 
+// Classes ...
+func (d *CIFAR10) Classes(ctx context.Context) ([]dldataset.Class, error) {
+	classes, err := d.classMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return classes.Classes(), nil
+}
+
+// LabelIndex ...
+func (d *CIFAR10) LabelIndex(ctx context.Context, name string) (int, error) {
+	classes, err := d.classMap(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return classes.LabelIndex(name)
+}
+
+// LabelName ...
+func (d *CIFAR10) LabelName(ctx context.Context, index int) (string, error) {
+	classes, err := d.classMap(ctx)
+	if err != nil {
+		return "", err
+	}
+	return classes.LabelName(index)
+}
+
+func (d *CIFAR10) classMap(ctx context.Context) (*dldataset.ClassMap, error) {
+	if d.classes != nil {
+		return d.classes, nil
+	}
+	if err := d.readLabels(ctx); err != nil {
+		return nil, err
+	}
+	d.classes = labelClasses(d.labels)
+	return d.classes, nil
+}
+
 func (d *CIFAR10) readLabels(ctx context.Context) error {
 	if len(d.labels) != 0 {
 		return nil
 	}
 
-	workingDir := d.workingDir()
-	labelFilePath := filepath.Join(workingDir, d.labelFileName)
+	labelFilePath := d.filePath(d.labelFileName)
 	if !com.IsFile(labelFilePath) {
-		return errors.Errorf("the label file %s was not found", labelFilePath)
+		return errors.Wrapf(dldataset.ErrNotDownloaded, "the label file %s was not found", labelFilePath)
 	}
 
 	var labels []string
@@ -314,9 +476,11 @@ func (d *CIFAR10) Close() error {
 }
 
 func (d *CIFAR10) workingDir() string {
-	category := strings.ToLower(d.Category())
-	name := strings.ToLower(d.Name())
-	return filepath.Join(d.baseWorkingDir, category, name)
+	return datasetWorkingDir(d.baseWorkingDir, d.CanonicalName())
+}
+
+func (d *CIFAR10) filePath(fileName string) string {
+	return datasetFilePath(d.workingDir(), d.CanonicalName(), fileName)
 }
 
 func init() {
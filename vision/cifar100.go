@@ -26,6 +26,9 @@ import (
 
 var cifar100 *CIFAR100
 
+// cifar100DiskSize is the number of bytes of cifar-100-binary.tar.gz
+const cifar100DiskSize = 168513733
+
 // CIFAR100 ...
 type CIFAR100 struct {
 	base
@@ -39,16 +42,20 @@ type CIFAR100 struct {
 	coarseLabelsFileName string
 	fineLabels           []string
 	coarseLabels         []string
+	classes              *dldataset.ClassMap
 	fineLabelByteSize    int
 	coarseLabelByteSize  int
 	pixelByteSize        int
 	imageDimensions      []int
 	data                 map[string]CIFAR100LabeledImage
+	names                []string
+	cursor               int
 	isDownloaded         bool
 }
 
 // CIFAR100LabeledImage ...
 type CIFAR100LabeledImage struct {
+	name        string
 	coarseLabel string
 	fineLabel   string
 	data        *types.RGBImage
@@ -86,6 +93,11 @@ func (l CIFAR100LabeledImage) Data() (interface{}, error) {
 	return l.data, nil
 }
 
+// Metadata ...
+func (l CIFAR100LabeledImage) Metadata() dldataset.Metadata {
+	return imageMetadata(l.name, l.data)
+}
+
 // Name ...
 func (*CIFAR100) Name() string {
 	return "CIFAR100"
@@ -99,9 +111,45 @@ func (d *CIFAR100) CanonicalName() string {
 	return key
 }
 
-// New ...
+// Info ...
+func (d *CIFAR100) Info() dldataset.DatasetInfo {
+	return dldataset.DatasetInfo{
+		CanonicalName: d.CanonicalName(),
+		Category:      d.Category(),
+		Name:          d.Name(),
+		Task:          dldataset.ClassificationTask,
+		Splits: map[string]int{
+			"train": 50000,
+			"test":  10000,
+		},
+		NumClasses: 100,
+		ImageSize:  d.imageDimensions,
+		DiskSize:   cifar100DiskSize,
+		URLs:       []string{d.url},
+		Citation:   cifarCitation,
+	}
+}
+
+// New returns an instance of the dataset that does not share any read state with d
 func (d *CIFAR100) New(ctx context.Context) (dldataset.Dataset, error) {
-	return cifar100, nil
+	return &CIFAR100{
+		base: base{
+			ctx:            ctx,
+			baseWorkingDir: d.baseWorkingDir,
+		},
+		url:                  d.url,
+		fileName:             d.fileName,
+		extractedFolderName:  d.extractedFolderName,
+		md5sum:               d.md5sum,
+		trainFileNameList:    d.trainFileNameList,
+		testFileNameList:     d.testFileNameList,
+		fineLabelsFileName:   d.fineLabelsFileName,
+		coarseLabelsFileName: d.coarseLabelsFileName,
+		imageDimensions:      d.imageDimensions,
+		fineLabelByteSize:    d.fineLabelByteSize,
+		coarseLabelByteSize:  d.coarseLabelByteSize,
+		pixelByteSize:        d.pixelByteSize,
+	}, nil
 }
 
 func (d *CIFAR100) Load(ctx context.Context) error {
@@ -113,24 +161,68 @@ func (d *CIFAR100) Download(ctx context.Context) error {
 	if d.isDownloaded {
 		return nil
 	}
+	if err := dldataset.DownloadFiles(ctx, d.datasetFiles(), d.Verify, d.download); err != nil {
+		return err
+	}
+	d.isDownloaded = true
+	return nil
+}
+
+// download downloads the archive and extracts the files of the dataset
+func (d *CIFAR100) download(ctx context.Context, verifyErr error) error {
 	workingDir := d.workingDir()
-	downloadedFileName := filepath.Join(workingDir, d.fileName)
-	downloadedFileName, ifDownload, err := downloadmanager.DownloadFile(d.url, downloadedFileName, downloadmanager.Context(ctx), downloadmanager.MD5Sum(d.md5sum))
+	archiveOutputDir := filepath.Join(workingDir, d.extractedFolderName)
+	downloadedFileName := d.filePath(d.fileName)
+	downloadedFileName, ifDownload, err := downloadFile(ctx, d.CanonicalName(), d.fileName, d.url, downloadedFileName, dldataset.Checksum{MD5: d.md5sum})
 	if err != nil {
 		return err
 	}
-	if ifDownload {
+	// the archive is extracted again if it was already downloaded but the files are corrupt
+	if ifDownload || !com.IsDir(archiveOutputDir) {
 		if err := downloadmanager.Unarchive(workingDir, downloadedFileName); err != nil {
 			return err
 		}
 	}
-	if err := d.move(ctx); err != nil {
-		return err
+	if !dldataset.Config.Dataset(d.CanonicalName()).KeepExtracted {
+		defer os.RemoveAll(archiveOutputDir)
 	}
-	archiveOutputDir := filepath.Join(workingDir, d.extractedFolderName)
-	defer os.RemoveAll(archiveOutputDir)
+	return d.move(ctx)
+}
 
-	return nil
+// datasetFiles describes the extracted files, which are recorded with the url of the
+// archive they were extracted from
+func (d *CIFAR100) datasetFiles() dldataset.DatasetFiles {
+	url := dldataset.MirrorURL(d.CanonicalName(), d.fileName, d.url)
+	urls := map[string]string{}
+	for _, fileName := range []string{d.fineLabelsFileName, d.coarseLabelsFileName} {
+		urls[fileName] = url
+	}
+	for fileName := range d.trainFileNameList {
+		urls[fileName] = url
+	}
+	for fileName := range d.testFileNameList {
+		urls[fileName] = url
+	}
+	return newDatasetFiles(d.workingDir(), d.CanonicalName(), urls)
+}
+
+// Verify checks the extracted files against the checksums of the manifest, or against
+// their md5 sums if they were not recorded in a manifest
+func (d *CIFAR100) Verify(ctx context.Context) error {
+	if ok, err := d.datasetFiles().VerifyManifest(); ok || err != nil {
+		return err
+	}
+	checksums := map[string]dldataset.Checksum{
+		d.fineLabelsFileName:   dldataset.Checksum{},
+		d.coarseLabelsFileName: dldataset.Checksum{},
+	}
+	for fileName, md5 := range d.trainFileNameList {
+		checksums[fileName] = dldataset.Checksum{MD5: md5}
+	}
+	for fileName, md5 := range d.testFileNameList {
+		checksums[fileName] = dldataset.Checksum{MD5: md5}
+	}
+	return verifyFiles(d.workingDir(), d.CanonicalName(), checksums)
 }
 
 func (d *CIFAR100) move(ctx context.Context) error {
@@ -146,51 +238,47 @@ func (d *CIFAR100) move(ctx context.Context) error {
 	for fileName, md5 := range filesHashes {
 		filePath := filepath.Join(archiveOutputDir, fileName)
 		if !com.IsFile(filePath) {
-			return errors.Errorf("the file %s for %s was not found in the extracted directory", fileName, d.CanonicalName())
+			return errors.Wrapf(dldataset.ErrNotDownloaded, "the file %s for %s was not found in the extracted directory", fileName, d.CanonicalName())
 		}
-		newPath := filepath.Join(workingDir, fileName)
-		if err := os.Rename(filePath, newPath); err != nil {
-			return errors.Wrapf(err, "cannot move the file %s to %s", filePath, newPath)
+		newPath := d.filePath(fileName)
+		if err := moveExtractedFile(d.CanonicalName(), filePath, newPath); err != nil {
+			return err
 		}
 		ok, err := utils.MD5Sum.CheckFile(newPath, md5)
 		if err != nil {
 			return err
 		}
 		if !ok {
-			return errors.Wrapf(err, "the md5 sum for %s did not match expected %s", newPath, md5)
+			return errors.Wrapf(dldataset.ErrChecksumMismatch, "the md5 sum for %s did not match expected %s", newPath, md5)
 		}
 	}
 	fineLabelFilePath := filepath.Join(archiveOutputDir, d.fineLabelsFileName)
 	if !com.IsFile(fineLabelFilePath) {
-		return errors.Errorf("the file %s for %s was not found in the extracted directory", fineLabelFilePath, d.CanonicalName())
+		return errors.Wrapf(dldataset.ErrNotDownloaded, "the file %s for %s was not found in the extracted directory", fineLabelFilePath, d.CanonicalName())
 	}
-	newFineLabelFilePath := filepath.Join(workingDir, d.fineLabelsFileName)
-	if err := os.Rename(fineLabelFilePath, newFineLabelFilePath); err != nil {
-		return errors.Wrapf(err, "cannot move the file %s to %s", fineLabelFilePath, newFineLabelFilePath)
+	newFineLabelFilePath := d.filePath(d.fineLabelsFileName)
+	if err := moveExtractedFile(d.CanonicalName(), fineLabelFilePath, newFineLabelFilePath); err != nil {
+		return err
 	}
 
 	coarseLabelFilePath := filepath.Join(archiveOutputDir, d.coarseLabelsFileName)
 	if !com.IsFile(coarseLabelFilePath) {
-		return errors.Errorf("the file %s for %s was not found in the extracted directory", coarseLabelFilePath, d.CanonicalName())
+		return errors.Wrapf(dldataset.ErrNotDownloaded, "the file %s for %s was not found in the extracted directory", coarseLabelFilePath, d.CanonicalName())
 	}
-	newCoarseLabelFilePath := filepath.Join(workingDir, d.coarseLabelsFileName)
-	if err := os.Rename(coarseLabelFilePath, newCoarseLabelFilePath); err != nil {
-		return errors.Wrapf(err, "cannot move the file %s to %s", coarseLabelFilePath, newCoarseLabelFilePath)
+	newCoarseLabelFilePath := d.filePath(d.coarseLabelsFileName)
+	if err := moveExtractedFile(d.CanonicalName(), coarseLabelFilePath, newCoarseLabelFilePath); err != nil {
+		return err
 	}
 
 	return nil
 }
 
 // List ...
-func (d *CIFAR100) List(ctx context.Context) ([]string, error) {
+func (d *CIFAR100) List(ctx context.Context, opts ...dldataset.ListOption) ([]string, error) {
 	if err := d.read(ctx); err != nil {
 		return nil, err
 	}
-	keys := []string{}
-	for key := range d.data {
-		keys = append(keys, key)
-	}
-	return keys, nil
+	return dldataset.ApplyListOptions(d.names, opts...)
 }
 
 // Get ...
@@ -200,13 +288,48 @@ func (d *CIFAR100) Get(ctx context.Context, name string) (dldataset.LabeledData,
 	}
 	data, ok := d.data[name]
 	if !ok {
-		return nil, errors.Errorf("unable to find %s in the %s dataset", name, d.CanonicalName())
+		return nil, errors.Wrapf(dldataset.ErrNotFound, "unable to find %s in the %s dataset", name, d.CanonicalName())
 	}
 	return data, nil
 }
 
+// Next ...
 func (d *CIFAR100) Next(ctx context.Context) (dldataset.LabeledData, error) {
-	return nil, errors.New("next iterator is not implemented for " + d.CanonicalName())
+	if err := d.read(ctx); err != nil {
+		return nil, err
+	}
+	if d.cursor >= len(d.names) {
+		return nil, io.EOF
+	}
+	data := d.data[d.names[d.cursor]]
+	d.cursor++
+	return data, nil
+}
+
+// Len ...
+func (d *CIFAR100) Len(ctx context.Context) (int, error) {
+	if err := d.read(ctx); err != nil {
+		return 0, err
+	}
+	return len(d.names), nil
+}
+
+// Reset ...
+func (d *CIFAR100) Reset(ctx context.Context) error {
+	d.cursor = 0
+	return nil
+}
+
+// Seek ...
+func (d *CIFAR100) Seek(ctx context.Context, index int) error {
+	if err := d.read(ctx); err != nil {
+		return err
+	}
+	if index < 0 || index > len(d.names) {
+		return errors.Errorf("the index %d is out of range %d", index, len(d.names))
+	}
+	d.cursor = index
+	return nil
 }
 
 func (d *CIFAR100) read(ctx context.Context) error {
@@ -224,12 +347,12 @@ func (d *CIFAR100) readData(ctx context.Context) error {
 		return nil
 	}
 
-	workingDir := d.workingDir()
 	data := map[string]CIFAR100LabeledImage{}
+	names := []string{}
 
 	read := func(offset int, class, fileName string) (int, error) {
 		idx := offset
-		filePath := filepath.Join(workingDir, fileName)
+		filePath := d.filePath(fileName)
 		f, err := os.Open(filePath)
 		if err != nil {
 			return idx, errors.Wrapf(err, "failed to open %s while performing md5 checksum", filePath)
@@ -239,18 +362,20 @@ func (d *CIFAR100) readData(ctx context.Context) error {
 		for {
 			entry, err := d.readEntry(ctx, f)
 			if err == io.EOF {
-				return 0, nil
+				return idx, nil
 			}
 			if err != nil {
 				return idx, errors.Wrapf(err, "failed reading entry for %s", filePath)
 			}
-			data[class+"/"+strconv.Itoa(idx)] = *entry
+			name := class + "/" + strconv.Itoa(idx)
+			entry.name = name
+			data[name] = *entry
+			names = append(names, name)
 			idx++
 		}
-		return idx, nil
 	}
 	idx := 0
-	for fileName := range d.trainFileNameList {
+	for _, fileName := range sortedKeys(d.trainFileNameList) {
 		newIdx, err := read(idx, "train", fileName)
 		if err != nil {
 			return err
@@ -258,7 +383,7 @@ func (d *CIFAR100) readData(ctx context.Context) error {
 		idx = newIdx
 	}
 	idx = 0
-	for fileName := range d.trainFileNameList {
+	for _, fileName := range sortedKeys(d.testFileNameList) {
 		newIdx, err := read(idx, "test", fileName)
 		if err != nil {
 			return err
@@ -267,12 +392,13 @@ func (d *CIFAR100) readData(ctx context.Context) error {
 	}
 
 	d.data = data
+	d.names = names
 
 	return nil
 }
 
 func (d *CIFAR100) readEntry(ctx context.Context, reader io.Reader) (*CIFAR100LabeledImage, error) {
-	var coarseLabelIdx int8
+	var coarseLabelIdx uint8
 	coarseLabelByteSize := int64(d.coarseLabelByteSize)
 	coarseLabelBytesReader := io.LimitReader(reader, coarseLabelByteSize)
 	err := binary.Read(coarseLabelBytesReader, binary.LittleEndian, &coarseLabelIdx)
@@ -280,24 +406,24 @@ func (d *CIFAR100) readEntry(ctx context.Context, reader io.Reader) (*CIFAR100La
 		return nil, err
 	}
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to read fine label")
+		return nil, errors.Wrap(dldataset.ErrCorruptRecord, "unable to read coarse label")
 	}
 	if int(coarseLabelIdx) >= len(d.coarseLabels) {
-		return nil, errors.Errorf("the coarse label %v is out of range of %v", coarseLabelIdx, len(d.coarseLabels))
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "the coarse label %v is out of range of %v", coarseLabelIdx, len(d.coarseLabels))
 	}
 
-	var fineLabelIdx int8
+	var fineLabelIdx uint8
 	fineLabelByteSize := int64(d.fineLabelByteSize)
 	fineLabelBytesReader := io.LimitReader(reader, fineLabelByteSize)
 	err = binary.Read(fineLabelBytesReader, binary.LittleEndian, &fineLabelIdx)
 	if err == io.EOF {
-		return nil, err
+		return nil, errors.Wrap(dldataset.ErrCorruptRecord, "unable to read fine label: the record is truncated")
 	}
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to read fine label")
+		return nil, errors.Wrap(dldataset.ErrCorruptRecord, "unable to read fine label")
 	}
 	if int(fineLabelIdx) >= len(d.fineLabels) {
-		return nil, errors.Errorf("the fine label %v is out of range of %v", fineLabelIdx, len(d.fineLabels))
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "the fine label %v is out of range of %v", fineLabelIdx, len(d.fineLabels))
 	}
 
 	pixelByteSize := int64(d.pixelByteSize)
@@ -306,11 +432,11 @@ func (d *CIFAR100) readEntry(ctx context.Context, reader io.Reader) (*CIFAR100La
 	img := types.NewRGBImage(image.Rect(0, 0, d.imageDimensions[0], d.imageDimensions[1]))
 
 	err = binary.Read(pixelBytesReader, binary.LittleEndian, img.Pix)
-	if err == io.EOF {
-		return nil, err
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, errors.Wrap(dldataset.ErrCorruptRecord, "unable to read the image: the record is truncated")
 	}
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to read label")
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "unable to read the image: %v", err)
 	}
 
 	return &CIFAR100LabeledImage{
@@ -320,16 +446,53 @@ func (d *CIFAR100) readEntry(ctx context.Context, reader io.Reader) (*CIFAR100La
 	}, nil
 }
 
+// Classes ...
+func (d *CIFAR100) Classes(ctx context.Context) ([]dldataset.Class, error) {
+	classes, err := d.classMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return classes.Classes(), nil
+}
+
+// LabelIndex ...
+func (d *CIFAR100) LabelIndex(ctx context.Context, name string) (int, error) {
+	classes, err := d.classMap(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return classes.LabelIndex(name)
+}
+
+// LabelName ...
+func (d *CIFAR100) LabelName(ctx context.Context, index int) (string, error) {
+	classes, err := d.classMap(ctx)
+	if err != nil {
+		return "", err
+	}
+	return classes.LabelName(index)
+}
+
+func (d *CIFAR100) classMap(ctx context.Context) (*dldataset.ClassMap, error) {
+	if d.classes != nil {
+		return d.classes, nil
+	}
+	if err := d.readLabels(ctx); err != nil {
+		return nil, err
+	}
+	d.classes = labelClasses(d.fineLabels)
+	return d.classes, nil
+}
+
 func (d *CIFAR100) readLabels(ctx context.Context) error {
 	if len(d.fineLabels) != 0 {
 		return nil
 	}
 
 	readLabelsFor := func(fileName string) ([]string, error) {
-		workingDir := d.workingDir()
-		labelFilePath := filepath.Join(workingDir, fileName)
+		labelFilePath := d.filePath(fileName)
 		if !com.IsFile(labelFilePath) {
-			return nil, errors.Errorf("the label file %s was not found", labelFilePath)
+			return nil, errors.Wrapf(dldataset.ErrNotDownloaded, "the label file %s was not found", labelFilePath)
 		}
 
 		var labels []string
@@ -368,9 +531,11 @@ func (d *CIFAR100) Close() error {
 }
 
 func (d *CIFAR100) workingDir() string {
-	category := strings.ToLower(d.Category())
-	name := strings.ToLower(d.Name())
-	return filepath.Join(d.baseWorkingDir, category, name)
+	return datasetWorkingDir(d.baseWorkingDir, d.CanonicalName())
+}
+
+func (d *CIFAR100) filePath(fileName string) string {
+	return datasetFilePath(d.workingDir(), d.CanonicalName(), fileName)
 }
 
 func init() {
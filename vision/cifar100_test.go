@@ -1,6 +1,7 @@
 package vision
 
 import (
+	"bytes"
 	"testing"
 
 	context "context"
@@ -37,3 +38,26 @@ func TestDownloadCIFAR100(t *testing.T) {
 	// pp.Println(lbl)
 
 }
+
+// TestCIFAR100ReadEntryCorrupt ...
+func TestCIFAR100ReadEntryCorrupt(t *testing.T) {
+	ctx := context.Background()
+	d := &CIFAR100{
+		coarseLabels:        []string{"aquatic_mammals", "fish"},
+		fineLabels:          []string{"apple", "aquarium_fish", "baby"},
+		coarseLabelByteSize: 1,
+		fineLabelByteSize:   1,
+		pixelByteSize:       12,
+		imageDimensions:     []int{2, 2, 3},
+	}
+
+	entry, err := d.readEntry(ctx, bytes.NewReader(append([]byte{1, 2}, make([]byte, 12)...)))
+	assert.NoError(t, err)
+	assert.Equal(t, "fish", entry.coarseLabel)
+	assert.Equal(t, "baby", entry.fineLabel)
+
+	for _, labels := range [][]byte{{0x80, 0}, {0, 0xff}, {2, 0}, {0, 3}} {
+		_, err = d.readEntry(ctx, bytes.NewReader(append(labels, make([]byte, 12)...)))
+		assert.True(t, dldataset.IsCorruptRecord(err))
+	}
+}
@@ -1,6 +1,7 @@
 package vision
 
 import (
+	"bytes"
 	"testing"
 
 	context "context"
@@ -26,6 +27,11 @@ func TestDownloadCIFAR10(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, fileList)
 
+	page, err := cifar10.List(ctx, dldataset.ListGlob("train/*"), dldataset.ListSorted(), dldataset.ListLimit(10))
+	assert.NoError(t, err)
+	assert.Len(t, page, 10)
+	assert.Equal(t, "train/0", page[0])
+
 	lbl, err := cifar10.Get(ctx, "train/1")
 	assert.NoError(t, err)
 	assert.NotEmpty(t, lbl)
@@ -34,6 +40,51 @@ func TestDownloadCIFAR10(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, lbl)
 
+	length, err := cifar10.Len(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, len(fileList), length)
+
+	err = cifar10.Seek(ctx, 1)
+	assert.NoError(t, err)
+
+	nxt, err := cifar10.Next(ctx)
+	assert.NoError(t, err)
+	expected, err := cifar10.Get(ctx, fileList[1])
+	assert.NoError(t, err)
+	assert.Equal(t, expected, nxt)
+
+	err = cifar10.Reset(ctx)
+	assert.NoError(t, err)
+
+	nxt, err = cifar10.Next(ctx)
+	assert.NoError(t, err)
+	expected, err = cifar10.Get(ctx, fileList[0])
+	assert.NoError(t, err)
+	assert.Equal(t, expected, nxt)
+
 	// pp.Println(lbl)
 
 }
+
+// TestCIFAR10ReadEntryCorrupt ...
+func TestCIFAR10ReadEntryCorrupt(t *testing.T) {
+	ctx := context.Background()
+	d := &CIFAR10{
+		labels:          []string{"airplane", "automobile"},
+		labelByteSize:   1,
+		pixelByteSize:   12,
+		imageDimensions: []int{2, 2, 3},
+	}
+
+	entry, err := d.readEntry(ctx, bytes.NewReader(append([]byte{1}, make([]byte, 12)...)))
+	assert.NoError(t, err)
+	assert.Equal(t, "automobile", entry.label)
+
+	for _, label := range []byte{2, 0x80, 0xff} {
+		_, err = d.readEntry(ctx, bytes.NewReader(append([]byte{label}, make([]byte, 12)...)))
+		assert.True(t, dldataset.IsCorruptRecord(err))
+	}
+
+	_, err = d.readEntry(ctx, bytes.NewReader([]byte{1, 0, 0}))
+	assert.True(t, dldataset.IsCorruptRecord(err))
+}
@@ -3,6 +3,8 @@ package vision
 import (
 	context "context"
 	"fmt"
+	"io"
+	"os"
 	"path"
 	"path/filepath"
 	"strings"
@@ -17,7 +19,6 @@ import (
 	"github.com/rai-project/dldataset/reader/tfrecord"
 	"github.com/rai-project/dlframework"
 	"github.com/rai-project/dlframework/framework/feature"
-	"github.com/rai-project/downloadmanager"
 	"github.com/rai-project/image/types"
 	protobuf "github.com/ubccr/terf/protobuf"
 )
@@ -42,14 +43,17 @@ type CocoValidationTFRecord struct {
 	baseURL          string
 	recordFileName   string
 	md5sum           string
+	numExamples      int
 	labelMap         *object_detection.StringIntLabelMap
 	completeLabelMap *object_detection.StringIntLabelMap
+	classes          *dldataset.ClassMap
 	recordReader     *reader.TFRecordReader
 }
 
 var (
 	coco2014ValidationTFRecord *CocoValidationTFRecord
 	coco2017ValidationTFRecord *CocoValidationTFRecord
+	coco2014MinivalTFRecord    dldataset.Dataset
 )
 
 // Label ...
@@ -62,6 +66,18 @@ func (l *CocoLabeledImage) Data() (interface{}, error) {
 	return l.data, nil
 }
 
+// Metadata ...
+func (l *CocoLabeledImage) Metadata() dldataset.Metadata {
+	return dldataset.Metadata{
+		ID:       cocoRecordName(l.sourceID, l.fileName),
+		SourceID: l.sourceID,
+		FileName: l.fileName,
+		Width:    int(l.width),
+		Height:   int(l.height),
+		SHA256:   l.sha256,
+	}
+}
+
 // Feature ...
 func (d *CocoLabeledImage) Feature() *dlframework.Feature {
 	return d.features[0]
@@ -72,6 +88,41 @@ func (d *CocoLabeledImage) Features() dlframework.Features {
 	return d.features
 }
 
+// Len ...
+func (d *CocoValidationTFRecord) Len(ctx context.Context) (int, error) {
+	idx, err := d.index(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return idx.Len(), nil
+}
+
+// Reset ...
+func (d *CocoValidationTFRecord) Reset(ctx context.Context) error {
+	if d.recordReader == nil {
+		return d.loadRecord(ctx)
+	}
+	_, err := d.recordReader.Seek(0, io.SeekStart)
+	return err
+}
+
+// Seek ...
+func (d *CocoValidationTFRecord) Seek(ctx context.Context, index int) error {
+	idx, err := d.index(ctx)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index > idx.Len() {
+		return errors.Errorf("the index %d is out of range %d", index, idx.Len())
+	}
+	if index == idx.Len() {
+		_, err = d.recordReader.Seek(0, io.SeekEnd)
+		return err
+	}
+	_, err = d.recordReader.Seek(idx.Entries[index].Offset, io.SeekStart)
+	return err
+}
+
 // Close ...
 func (d *CocoValidationTFRecord) Close() error {
 	if d.recordReader != nil {
@@ -93,57 +144,171 @@ func (d *CocoValidationTFRecord) CanonicalName() string {
 	return key
 }
 
+// Info ...
+func (d *CocoValidationTFRecord) Info() dldataset.DatasetInfo {
+	return dldataset.DatasetInfo{
+		CanonicalName: d.CanonicalName(),
+		Category:      d.Category(),
+		Name:          d.Name(),
+		Task:          dldataset.DetectionTask,
+		Splits: map[string]int{
+			"validation": d.numExamples,
+		},
+		NumClasses: len(d.labelMap.Item),
+		DiskSize:   d.datasetFiles().DiskSize(),
+		URLs:       []string{urlJoin(d.baseURL, d.recordFileName)},
+		License:    "annotations under CC BY 4.0, images under the Flickr terms of use",
+		Citation:   "Tsung-Yi Lin et al. Microsoft COCO: Common Objects in Context. European Conference on Computer Vision, 2014.",
+	}
+}
+
 func (d *CocoValidationTFRecord) workingDir() string {
-	category := strings.ToLower(d.Category())
-	name := strings.ToLower(d.Name())
-	return filepath.Join(d.baseWorkingDir, category, name)
+	return datasetWorkingDir(d.baseWorkingDir, d.CanonicalName())
+}
+
+func (d *CocoValidationTFRecord) filePath(fileName string) string {
+	return datasetFilePath(d.workingDir(), d.CanonicalName(), fileName)
 }
 
 // Download ...
 func (d *CocoValidationTFRecord) Download(ctx context.Context) error {
-	workingDir := d.workingDir()
+	return dldataset.DownloadFiles(ctx, d.datasetFiles(), d.Verify, d.download)
+}
+
+// download downloads the record file
+func (d *CocoValidationTFRecord) download(ctx context.Context, verifyErr error) error {
 	fileName := d.recordFileName
-	downloadedFileName := filepath.Join(workingDir, fileName)
+	downloadedFileName := d.filePath(fileName)
+	// a partial or corrupt file from an interrupted download is downloaded again
 	if com.IsFile(downloadedFileName) {
-		return nil
+		if err := os.Remove(downloadedFileName); err != nil {
+			return errors.Wrapf(err, "cannot remove %v", downloadedFileName)
+		}
 	}
-	downloadedFileName, _, err := downloadmanager.DownloadFile(
-		urlJoin(d.baseURL, fileName),
-		downloadedFileName,
-		downloadmanager.Context(ctx),
-	)
+	_, _, err := downloadFile(ctx, d.CanonicalName(), fileName, urlJoin(d.baseURL, fileName), downloadedFileName, dldataset.Checksum{MD5: d.md5sum})
 	if err != nil {
 		return errors.Wrapf(err, "failed to download %v", fileName)
 	}
 	return nil
 }
 
-// New ...
+func (d *CocoValidationTFRecord) datasetFiles() dldataset.DatasetFiles {
+	return newDatasetFiles(d.workingDir(), d.CanonicalName(), map[string]string{
+		d.recordFileName: dldataset.MirrorURL(d.CanonicalName(), d.recordFileName, urlJoin(d.baseURL, d.recordFileName)),
+	})
+}
+
+// Verify checks the record file against the checksum of the manifest, or against its
+// md5 sum if it was not recorded in a manifest
+func (d *CocoValidationTFRecord) Verify(ctx context.Context) error {
+	if ok, err := d.datasetFiles().VerifyManifest(); ok || err != nil {
+		return err
+	}
+	return verifyFiles(d.workingDir(), d.CanonicalName(), map[string]dldataset.Checksum{
+		d.recordFileName: {MD5: d.md5sum},
+	})
+}
+
+// Classes ...
+func (d *CocoValidationTFRecord) Classes(ctx context.Context) ([]dldataset.Class, error) {
+	return d.classes.Classes(), nil
+}
+
+// LabelIndex ...
+func (d *CocoValidationTFRecord) LabelIndex(ctx context.Context, name string) (int, error) {
+	return d.classes.LabelIndex(name)
+}
+
+// LabelName ...
+func (d *CocoValidationTFRecord) LabelName(ctx context.Context, index int) (string, error) {
+	return d.classes.LabelName(index)
+}
+
+// New returns an instance of the dataset with its own record reader
 func (d *CocoValidationTFRecord) New(ctx context.Context) (dldataset.Dataset, error) {
-	return nil, nil
+	return &CocoValidationTFRecord{
+		base: base{
+			ctx:            ctx,
+			baseWorkingDir: d.baseWorkingDir,
+		},
+		name:             d.name,
+		baseURL:          d.baseURL,
+		recordFileName:   d.recordFileName,
+		md5sum:           d.md5sum,
+		numExamples:      d.numExamples,
+		labelMap:         d.labelMap,
+		completeLabelMap: d.completeLabelMap,
+		classes:          d.classes,
+	}, nil
 }
 
-// Get ...
+// Get returns the record whose image/source_id or image/filename matches the name
 func (d *CocoValidationTFRecord) Get(ctx context.Context, name string) (dldataset.LabeledData, error) {
-	return nil, errors.New("get is not implemented for " + d.CanonicalName())
+	idx, err := d.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	offset, ok := idx.Lookup(name)
+	if !ok {
+		return nil, errors.Wrapf(dldataset.ErrNotFound, "unable to find %s in the %s dataset", name, d.CanonicalName())
+	}
+	rec, err := d.recordReader.ReadRecordAt(ctx, offset)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v from the %v dataset", name, d.CanonicalName())
+	}
+
+	data, err := NewCocoLabeledImageFromRecord(rec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v from the %v dataset", name, d.CanonicalName())
+	}
+	return data, nil
+}
+
+// List returns the image/source_id of each record in file order, which is the COCO image
+// id that the minival subset is defined by. Get also accepts the image/filename.
+func (d *CocoValidationTFRecord) List(ctx context.Context, opts ...dldataset.ListOption) ([]string, error) {
+	idx, err := d.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, idx.Len())
+	for ii, entry := range idx.Entries {
+		names[ii] = cocoRecordName(entry.SourceID, entry.FileName)
+	}
+	return dldataset.ApplyListOptions(names, opts...)
 }
 
-// List ...
-func (d *CocoValidationTFRecord) List(ctx context.Context) ([]string, error) {
-	return nil, errors.New("list is not implemented for " + d.CanonicalName())
+// cocoRecordName returns the name that List and Metadata report for a record, which is
+// its source id or its file name for records without one
+func cocoRecordName(sourceID, fileName string) string {
+	if sourceID != "" {
+		return sourceID
+	}
+	return fileName
+}
+
+func (d *CocoValidationTFRecord) index(ctx context.Context) (*reader.TFRecordIndex, error) {
+	if d.recordReader == nil {
+		if err := d.loadRecord(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return d.recordReader.Index(ctx)
 }
 
 func (d *CocoValidationTFRecord) loadRecord(ctx context.Context) error {
-	workingDir := d.workingDir()
-	recordFileName := filepath.Join(workingDir, d.recordFileName)
+	recordFileName := d.filePath(d.recordFileName)
 	if !com.IsFile(recordFileName) {
-		return errors.Errorf("unable to find the record file in %v make sure to download the dataset first", recordFileName)
+		return errors.Wrapf(dldataset.ErrNotDownloaded, "unable to find the record file in %v make sure to download the dataset first", recordFileName)
 	}
 
-	recordIOReader, err := reader.NewTFRecordReader(recordFileName)
+	recordIOReader, err := reader.NewTFRecordReader(recordFileName, readerOptions()...)
 	if err != nil {
 		return errors.Wrapf(err, "failed to load record from %v", recordFileName)
 	}
+	if d.recordReader != nil {
+		d.recordReader.Close()
+	}
 	d.recordReader = recordIOReader
 	return nil
 }
@@ -155,15 +320,19 @@ func (d *CocoValidationTFRecord) Load(ctx context.Context) error {
 
 // Next ...
 func (d *CocoValidationTFRecord) Next(ctx context.Context) (dldataset.LabeledData, error) {
-	rec, err := d.recordReader.NextRecord(ctx)
+	rec, err := d.recordReader.NextDecoded(ctx, func(ctx context.Context, rec *protobuf.Example) (interface{}, error) {
+		return NewCocoLabeledImageFromRecord(rec)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return NewCocoLabeledImageFromRecord(rec), nil
+	return rec.(dldataset.LabeledData), nil
 }
 
-func NewCocoLabeledImageFromRecord(rec *protobuf.Example) *CocoLabeledImage {
+// NewCocoLabeledImageFromRecord decodes the record. It returns an error wrapping
+// dldataset.ErrCorruptRecord if the image cannot be decoded.
+func NewCocoLabeledImageFromRecord(rec *protobuf.Example) (*CocoLabeledImage, error) {
 	height := tfrecord.FeatureInt64(rec, "image/height")
 	width := tfrecord.FeatureInt64(rec, "image/width")
 	fileName := tfrecord.FeatureString(rec, "image/filename")
@@ -172,7 +341,7 @@ func NewCocoLabeledImageFromRecord(rec *protobuf.Example) *CocoLabeledImage {
 	imgFormat := tfrecord.FeatureString(rec, "image/format")
 	img, err := getImageRecord(tfrecord.FeatureBytes(rec, "image/encoded"), imgFormat)
 	if err != nil {
-		panic(err)
+		return nil, errors.Wrapf(err, "cannot decode the image %v", fileName)
 	}
 	bboxXmin := tfrecord.FeatureFloat32Slice(rec, "image/object/bbox/xmin")
 	bboxXmax := tfrecord.FeatureFloat32Slice(rec, "image/object/bbox/xmax")
@@ -183,6 +352,17 @@ func NewCocoLabeledImageFromRecord(rec *protobuf.Example) *CocoLabeledImage {
 	area := tfrecord.FeatureFloat32Slice(rec, "image/object/area")
 
 	numBBoxes := len(bboxXmax)
+	err = checkObjectCount(fileName, numBBoxes, map[string]int{
+		"image/object/bbox/xmin":  len(bboxXmin),
+		"image/object/bbox/ymin":  len(bboxYmin),
+		"image/object/bbox/ymax":  len(bboxYmax),
+		"image/object/class/text": len(class),
+		"image/object/is_crowd":   len(isCrowd),
+		"image/object/area":       len(area),
+	})
+	if err != nil {
+		return nil, err
+	}
 	features := make([]*dlframework.Feature, numBBoxes)
 	for ii := 0; ii < numBBoxes; ii++ {
 		features[ii] = feature.New(
@@ -207,7 +387,7 @@ func NewCocoLabeledImageFromRecord(rec *protobuf.Example) *CocoLabeledImage {
 		isCrowd:  isCrowd,
 		features: features,
 		data:     img,
-	}
+	}, nil
 }
 
 func init() {
@@ -235,8 +415,10 @@ func init() {
 			baseURL:          baseURLPrefix + "/coco2014",
 			labelMap:         labelMap,
 			completeLabelMap: completeLabelMap,
+			classes:          labelMapClasses(labelMap),
 			recordFileName:   "coco_val.record-00000-of-00001",
 			md5sum:           "b1f63512f72d3c84792a1f53ec40062a",
+			numExamples:      40504,
 		}
 
 		coco2017ValidationTFRecord = &CocoValidationTFRecord{
@@ -248,11 +430,18 @@ func init() {
 			baseURL:          baseURLPrefix + "/coco2017",
 			labelMap:         labelMap,
 			completeLabelMap: completeLabelMap,
+			classes:          labelMapClasses(labelMap),
 			recordFileName:   "coco_val.record-00000-of-00001",
 			md5sum:           "b8a0cfed5ad569d4572b4ad8645acb5b",
+			numExamples:      5000,
 		}
 
+		// the minival image ids match the image/source_id of the coco2014 validation records
+		minivalIDs := strings.Fields(_escFSMustString(false, "/vision/support/mscoco_minival_ids.txt"))
+		coco2014MinivalTFRecord = dldataset.Subset(coco2014ValidationTFRecord, "coco2014_minival", minivalIDs)
+
 		dldataset.Register(coco2014ValidationTFRecord)
+		dldataset.Register(coco2014MinivalTFRecord)
 		dldataset.Register(coco2017ValidationTFRecord)
 	})
 }
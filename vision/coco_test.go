@@ -0,0 +1,99 @@
+package vision
+
+import (
+	"bytes"
+	goimage "image"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	context "context"
+	"github.com/rai-project/dldataset"
+	"github.com/stretchr/testify/assert"
+	"github.com/ubccr/terf"
+	protobuf "github.com/ubccr/terf/protobuf"
+)
+
+func bytesFeature(values ...string) *protobuf.Feature {
+	list := &protobuf.BytesList{}
+	for _, value := range values {
+		list.Value = append(list.Value, []byte(value))
+	}
+	return &protobuf.Feature{Kind: &protobuf.Feature_BytesList{BytesList: list}}
+}
+
+func int64Feature(value int64) *protobuf.Feature {
+	return &protobuf.Feature{Kind: &protobuf.Feature_Int64List{Int64List: &protobuf.Int64List{Value: []int64{value}}}}
+}
+
+// writeCocoTFRecord writes an image without objects for each of the source ids
+func writeCocoTFRecord(t *testing.T, fileName string, sourceIDs []string) {
+	img := goimage.NewRGBA(goimage.Rect(0, 0, 2, 2))
+	data := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(data, img))
+
+	f, err := os.Create(fileName)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer f.Close()
+	w := terf.NewWriter(f)
+	for _, sourceID := range sourceIDs {
+		err := w.Write(&protobuf.Example{
+			Features: &protobuf.Features{
+				Feature: map[string]*protobuf.Feature{
+					"image/height":    int64Feature(2),
+					"image/width":     int64Feature(2),
+					"image/filename":  bytesFeature("COCO_val2014_" + sourceID + ".jpg"),
+					"image/source_id": bytesFeature(sourceID),
+					"image/format":    bytesFeature("png"),
+					"image/encoded":   bytesFeature(data.String()),
+				},
+			},
+		})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Flush())
+}
+
+// TestCocoMinivalNames ...
+func TestCocoMinivalNames(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	d := &CocoValidationTFRecord{
+		base: base{
+			ctx:            ctx,
+			baseWorkingDir: dir,
+		},
+		name:           "coco2014",
+		recordFileName: "coco_val.record",
+	}
+	assert.NoError(t, os.MkdirAll(d.workingDir(), os.ModePerm))
+	writeCocoTFRecord(t, d.filePath(d.recordFileName), []string{"42", "73", "74"})
+	assert.NoError(t, d.Load(ctx))
+	defer d.Close()
+
+	// the minival ids are source ids and are not in the order of the records
+	for _, dataset := range []dldataset.Dataset{d, dldataset.Subset(d, "coco2014_minival", []string{"74", "42"})} {
+		lst, err := dataset.List(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, dataset.Reset(ctx))
+		for _, name := range lst {
+			data, err := dataset.Next(ctx)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, name, data.Metadata().ID)
+			assert.Equal(t, "COCO_val2014_"+name+".jpg", data.Metadata().FileName)
+		}
+	}
+
+	// the records can still be looked up by their file names
+	data, err := d.Get(ctx, "COCO_val2014_73.jpg")
+	assert.NoError(t, err)
+	assert.Equal(t, "73", data.Metadata().ID)
+}
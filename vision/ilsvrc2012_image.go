@@ -1,13 +1,20 @@
 package vision
 
 import (
+	"github.com/rai-project/dldataset"
 	"github.com/rai-project/dlframework"
 	"github.com/rai-project/dlframework/framework/feature"
 	"github.com/rai-project/image/types"
 )
 
+const (
+	imagenetLicense  = "ImageNet terms of access, for non-commercial research and educational use only"
+	imagenetCitation = "Olga Russakovsky et al. ImageNet Large Scale Visual Recognition Challenge. International Journal of Computer Vision, 2015."
+)
+
 // ILSVRC2012ValidationLabeledImage ...
 type ILSVRC2012ValidationLabeledImage struct {
+	name  string
 	label string
 	data  *types.RGBImage
 }
@@ -22,6 +29,11 @@ func (l ILSVRC2012ValidationLabeledImage) Data() (interface{}, error) {
 	return l.data, nil
 }
 
+// Metadata ...
+func (l ILSVRC2012ValidationLabeledImage) Metadata() dldataset.Metadata {
+	return imageMetadata(l.name, l.data)
+}
+
 // Feature ...
 func (d *ILSVRC2012ValidationLabeledImage) Feature() *dlframework.Feature {
 	return feature.New(
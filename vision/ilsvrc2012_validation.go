@@ -2,22 +2,24 @@ package vision
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
+	"os"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	context "context"
 
 	"github.com/Unknwon/com"
-	"github.com/k0kubun/pp"
 	"github.com/pkg/errors"
 	"github.com/rai-project/config"
 	"github.com/rai-project/dldataset"
 	"github.com/rai-project/dldataset/reader"
 	"github.com/rai-project/dlframework"
 	"github.com/rai-project/dlframework/framework/feature"
-	"github.com/rai-project/downloadmanager"
 	"github.com/spf13/cast"
 	"golang.org/x/sync/errgroup"
 )
@@ -42,6 +44,10 @@ var (
 	iLSVRC2012Test299CenterCrop875RecordIO *ILSVRC2012ValidationRecordIO
 )
 
+// iLSVRC2012ResizeSize is the size of the shorter side of the images of the variants
+// whose images do not have a fixed size
+const iLSVRC2012ResizeSize = 256
+
 // ILSVRC2012ValidationFolder ...
 type ILSVRC2012ValidationRecordIO struct {
 	base
@@ -51,18 +57,17 @@ type ILSVRC2012ValidationRecordIO struct {
 	indexFileName     string
 	recordFileName    string
 	recordReader      *reader.RecordIOReader
-	fileOffsetMapping map[string]recordIoOffset
+	files             []string
+	fileOffsetMapping map[string]int64
+	offsetFileNames   map[int64]string
+	recordOffsets     []int64
 	centerCrop        float64
 	isTestSet         bool
 }
 
 type iLSVRC2012ValidationRecordIOLabeledData struct {
 	*reader.ImageRecord
-}
-
-type recordIoOffset struct {
-	start int
-	end   int
+	fileName string
 }
 
 func (d *iLSVRC2012ValidationRecordIOLabeledData) Label() string {
@@ -86,9 +91,46 @@ func (d *iLSVRC2012ValidationRecordIOLabeledData) Data() (interface{}, error) {
 	return d.Image, nil
 }
 
+// Metadata ...
+func (d *iLSVRC2012ValidationRecordIOLabeledData) Metadata() dldataset.Metadata {
+	metadata := imageMetadata(d.fileName, d.Image)
+	metadata.SourceID = strconv.FormatUint(d.ID, 10)
+	metadata.FileName = d.fileName
+	return metadata
+}
+
+// New returns an instance of the dataset with its own record reader
 func (d *ILSVRC2012ValidationRecordIO) New(ctx context.Context) (dldataset.Dataset, error) {
-	return nil, nil
+	return &ILSVRC2012ValidationRecordIO{
+		base: base{
+			ctx:            ctx,
+			baseWorkingDir: d.baseWorkingDir,
+		},
+		imageSize:      d.imageSize,
+		baseURL:        d.baseURL,
+		listFileName:   d.listFileName,
+		indexFileName:  d.indexFileName,
+		recordFileName: d.recordFileName,
+		centerCrop:     d.centerCrop,
+		isTestSet:      d.isTestSet,
+	}, nil
+}
+
+// Classes ...
+func (d *ILSVRC2012ValidationRecordIO) Classes(ctx context.Context) ([]dldataset.Class, error) {
+	return synsetClasses.Classes(), nil
+}
+
+// LabelIndex ...
+func (d *ILSVRC2012ValidationRecordIO) LabelIndex(ctx context.Context, name string) (int, error) {
+	return synsetClasses.LabelIndex(name)
+}
+
+// LabelName ...
+func (d *ILSVRC2012ValidationRecordIO) LabelName(ctx context.Context, index int) (string, error) {
+	return synsetClasses.LabelName(index)
 }
+
 func (d *ILSVRC2012ValidationRecordIO) Name() string {
 	ty := "validation"
 	if d.isTestSet {
@@ -111,108 +153,204 @@ func (d *ILSVRC2012ValidationRecordIO) CanonicalName() string {
 	return key
 }
 
+// Info ...
+func (d *ILSVRC2012ValidationRecordIO) Info() dldataset.DatasetInfo {
+	split, numExamples := "validation", 50000
+	if d.isTestSet {
+		split, numExamples = "test", 100000
+	}
+	preprocessing := []string{}
+	if d.centerCrop != 0 {
+		preprocessing = append(preprocessing, fmt.Sprintf("center_crop_%d", int(10*d.centerCrop)))
+	}
+	var imageSize []int
+	if d.imageSize != 0 {
+		preprocessing = append(preprocessing, "resize_"+cast.ToString(d.imageSize))
+		imageSize = []int{d.imageSize, d.imageSize, 3}
+	} else {
+		preprocessing = append(preprocessing, "resize_shorter_side_"+cast.ToString(iLSVRC2012ResizeSize))
+	}
+	urls := []string{}
+	for _, fileName := range []string{d.listFileName, d.indexFileName, d.recordFileName} {
+		urls = append(urls, urlJoin(d.baseURL, fileName))
+	}
+	return dldataset.DatasetInfo{
+		CanonicalName: d.CanonicalName(),
+		Category:      d.Category(),
+		Name:          d.Name(),
+		Task:          dldataset.ClassificationTask,
+		Splits: map[string]int{
+			split: numExamples,
+		},
+		NumClasses:    len(synset),
+		ImageSize:     imageSize,
+		Preprocessing: preprocessing,
+		DiskSize:      d.datasetFiles().DiskSize(),
+		URLs:          urls,
+		License:       imagenetLicense,
+		Citation:      imagenetCitation,
+	}
+}
+
 func (d *ILSVRC2012ValidationRecordIO) workingDir() string {
-	category := strings.ToLower(d.Category())
-	name := strings.ToLower(d.Name())
-	return filepath.Join(d.baseWorkingDir, category, name)
+	return datasetWorkingDir(d.baseWorkingDir, d.CanonicalName())
+}
+
+func (d *ILSVRC2012ValidationRecordIO) filePath(fileName string) string {
+	return datasetFilePath(d.workingDir(), d.CanonicalName(), fileName)
 }
 
 func (d *ILSVRC2012ValidationRecordIO) Download(ctx context.Context) error {
-	grp, ctx := errgroup.WithContext(ctx)
+	if err := dldataset.DownloadFiles(ctx, d.datasetFiles(), d.Verify, d.download); err != nil {
+		return err
+	}
+	_, err := d.populate(ctx)
+	return err
+}
+
+// download downloads the list, index and record files
+func (d *ILSVRC2012ValidationRecordIO) download(ctx context.Context, verifyErr error) error {
 	files := []string{d.listFileName, d.indexFileName, d.recordFileName}
-	workingDir := d.workingDir()
+	if !dldataset.IsNotDownloaded(verifyErr) {
+		// the files are partial or corrupt, so they are all downloaded again
+		for _, fileName := range files {
+			if err := os.Remove(d.filePath(fileName)); err != nil && !os.IsNotExist(err) {
+				return errors.Wrapf(err, "cannot remove %v", fileName)
+			}
+		}
+	}
+	grp, ctx := errgroup.WithContext(ctx)
 	for ii := range files {
 		fileName := files[ii]
 		grp.Go(func() error {
-			downloadedFileName := filepath.Join(workingDir, fileName)
+			downloadedFileName := d.filePath(fileName)
 			if com.IsFile(downloadedFileName) {
 				return nil
 			}
-			pp.Println(downloadedFileName)
-			downloadedFileName, _, err := downloadmanager.DownloadFile(
-				urlJoin(d.baseURL, fileName),
-				downloadedFileName,
-				downloadmanager.Context(ctx),
-			)
+			_, _, err := downloadFile(ctx, d.CanonicalName(), fileName, urlJoin(d.baseURL, fileName), downloadedFileName, dldataset.Checksum{})
 			if err != nil {
 				return errors.Wrapf(err, "failed to download %v", fileName)
 			}
 			return nil
 		})
 	}
-	err := grp.Wait()
-	if err != nil {
+	return grp.Wait()
+}
+
+func (d *ILSVRC2012ValidationRecordIO) datasetFiles() dldataset.DatasetFiles {
+	urls := map[string]string{}
+	for _, fileName := range []string{d.listFileName, d.indexFileName, d.recordFileName} {
+		urls[fileName] = dldataset.MirrorURL(d.CanonicalName(), fileName, urlJoin(d.baseURL, fileName))
+	}
+	return newDatasetFiles(d.workingDir(), d.CanonicalName(), urls)
+}
+
+// Verify checks the list, index and record files against the checksums of the manifest.
+// No checksums are published for these files, so if they were not recorded in a manifest
+// it checks them against the checksums of the dataset's config and that the record file
+// agrees with the index. Files without a checksum are reported as unverified, or fail the
+// verification if the config requires checksums.
+func (d *ILSVRC2012ValidationRecordIO) Verify(ctx context.Context) error {
+	if ok, err := d.datasetFiles().VerifyManifest(); ok || err != nil {
 		return err
 	}
-	_, err = d.populate(ctx)
+	err := verifyFiles(d.workingDir(), d.CanonicalName(), map[string]dldataset.Checksum{
+		d.listFileName:   {},
+		d.indexFileName:  {},
+		d.recordFileName: {},
+	})
 	if err != nil {
 		return err
 	}
-	return nil
-}
-
-func keysFileOffset(s map[string]recordIoOffset) []string {
-	keys := make([]string, len(s))
-
-	ii := 0
-	for k := range s {
-		keys[ii] = k
-		ii++
-	}
-	return keys
+	return reader.VerifyRecordIO(d.filePath(d.recordFileName), d.filePath(d.indexFileName))
 }
 
 func (d *ILSVRC2012ValidationRecordIO) populate(ctx context.Context) ([]string, error) {
 
-	workingDir := d.workingDir()
-	listFileName := filepath.Join(workingDir, d.listFileName)
+	listFileName := d.filePath(d.listFileName)
 	if !com.IsFile(listFileName) {
-		return nil, errors.Errorf("unable to find the list file in %v make sure to download the dataset first", listFileName)
+		return nil, errors.Wrapf(dldataset.ErrNotDownloaded, "unable to find the list file in %v make sure to download the dataset first", listFileName)
+	}
+	indexFileName := d.filePath(d.indexFileName)
+	if !com.IsFile(indexFileName) {
+		return nil, errors.Wrapf(dldataset.ErrNotDownloaded, "unable to find the index file in %v make sure to download the dataset first", indexFileName)
 	}
 
-	pp.Println(listFileName)
+	log.WithField("dataset", d.CanonicalName()).
+		WithField("path", listFileName).
+		Debug("reading the list file")
 	bts, err := ioutil.ReadFile(listFileName)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to read %v", listFileName)
 	}
 
+	recordOffsets, err := reader.ReadRecordIOIndex(indexFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	// each line of the list file is of the form "index\tlabel\tpath", where
+	// the index is the key of the record within the index file
 	fileContent := strings.TrimSpace(string(bts))
 	lines := strings.Split(fileContent, "\n")
-	files := make([]string, len(lines))
-	d.fileOffsetMapping = make(map[string]recordIoOffset)
-	for ii, line := range lines {
+	fileOffsetMapping := make(map[string]int64)
+	// the records are matched to their files by offset, since im2rec does not
+	// write the index of the list file to the header of the records
+	offsetFileNames := make(map[int64]string)
+	for _, line := range lines {
 		fields := strings.Fields(line)
 		fileName := fields[len(fields)-1]
-		d.fileOffsetMapping[fileName] = recordIoOffset{
-			start: cast.ToInt(fields[0]),
-			end:   cast.ToInt(fields[1]),
+		offset, ok := recordOffsets[fields[0]]
+		if !ok {
+			return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "the record %v for %v was not found in %v", fields[0], fileName, indexFileName)
 		}
-		files[ii] = fileName
+		fileOffsetMapping[fileName] = offset
+		offsetFileNames[offset] = fileName
+	}
+
+	// the offsets in ascending order are the order in which Next reads the records
+	offsets := make([]int64, 0, len(fileOffsetMapping))
+	for _, offset := range fileOffsetMapping {
+		offsets = append(offsets, offset)
 	}
+	sort.Slice(offsets, func(ii, jj int) bool { return offsets[ii] < offsets[jj] })
+	files := make([]string, len(offsets))
+	for ii, offset := range offsets {
+		files[ii] = offsetFileNames[offset]
+	}
+
+	d.files = files
+	d.fileOffsetMapping = fileOffsetMapping
+	d.offsetFileNames = offsetFileNames
+	d.recordOffsets = offsets
 
 	return files, nil
 }
 
-func (d *ILSVRC2012ValidationRecordIO) List(ctx context.Context) ([]string, error) {
-
-	if len(d.fileOffsetMapping) != 0 {
-		return d.populate(ctx)
+// List returns the file names of the records in the order of the record file, which is the
+// order Next returns them in rather than the order of the list file
+func (d *ILSVRC2012ValidationRecordIO) List(ctx context.Context, opts ...dldataset.ListOption) ([]string, error) {
+	if len(d.files) == 0 {
+		if _, err := d.populate(ctx); err != nil {
+			return nil, err
+		}
 	}
-
-	return keysFileOffset(d.fileOffsetMapping), nil
+	return dldataset.ApplyListOptions(d.files, opts...)
 }
 
 func (d *ILSVRC2012ValidationRecordIO) loadRecord(ctx context.Context) error {
-	workingDir := d.workingDir()
-	recordFileName := filepath.Join(workingDir, d.recordFileName)
+	recordFileName := d.filePath(d.recordFileName)
 	if !com.IsFile(recordFileName) {
-		return errors.Errorf("unable to find the record file in %v make sure to download the dataset first", recordFileName)
+		return errors.Wrapf(dldataset.ErrNotDownloaded, "unable to find the record file in %v make sure to download the dataset first", recordFileName)
 	}
 
-	recordIOReader, err := reader.NewRecordIOReader(recordFileName)
+	recordIOReader, err := reader.NewRecordIOReader(recordFileName, readerOptions()...)
 	if err != nil {
 		return errors.Wrapf(err, "failed to load record from %v", recordFileName)
 	}
+	if d.recordReader != nil {
+		d.recordReader.Close()
+	}
 	d.recordReader = recordIOReader
 	return nil
 }
@@ -222,10 +360,40 @@ func (d *ILSVRC2012ValidationRecordIO) Load(ctx context.Context) error {
 }
 
 func (d *ILSVRC2012ValidationRecordIO) Get(ctx context.Context, name string) (dldataset.LabeledData, error) {
-	return nil, errors.New("get is not implemented for " + d.CanonicalName())
+	if len(d.fileOffsetMapping) == 0 {
+		if _, err := d.populate(ctx); err != nil {
+			return nil, err
+		}
+	}
+	offset, ok := d.fileOffsetMapping[name]
+	if !ok {
+		return nil, errors.Wrapf(dldataset.ErrNotFound, "unable to find %s in the %s dataset", name, d.CanonicalName())
+	}
+
+	if d.recordReader == nil {
+		if err := d.loadRecord(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	rec, err := d.recordReader.ReadAt(ctx, offset)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v from the %v dataset", name, d.CanonicalName())
+	}
+
+	return &iLSVRC2012ValidationRecordIOLabeledData{
+		ImageRecord: rec,
+		fileName:    name,
+	}, nil
 }
 
 func (d *ILSVRC2012ValidationRecordIO) Next(ctx context.Context) (dldataset.LabeledData, error) {
+	if len(d.offsetFileNames) == 0 {
+		if _, err := d.populate(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	rec, err := d.recordReader.Next(ctx)
 	if err != nil {
 		return nil, err
@@ -233,9 +401,48 @@ func (d *ILSVRC2012ValidationRecordIO) Next(ctx context.Context) (dldataset.Labe
 
 	return &iLSVRC2012ValidationRecordIOLabeledData{
 		ImageRecord: rec,
+		fileName:    d.offsetFileNames[rec.Offset],
 	}, nil
 }
 
+func (d *ILSVRC2012ValidationRecordIO) Len(ctx context.Context) (int, error) {
+	files, err := d.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(files), nil
+}
+
+func (d *ILSVRC2012ValidationRecordIO) Reset(ctx context.Context) error {
+	if d.recordReader == nil {
+		return d.loadRecord(ctx)
+	}
+	_, err := d.recordReader.Seek(0, io.SeekStart)
+	return err
+}
+
+func (d *ILSVRC2012ValidationRecordIO) Seek(ctx context.Context, index int) error {
+	if len(d.recordOffsets) == 0 {
+		if _, err := d.populate(ctx); err != nil {
+			return err
+		}
+	}
+	if index < 0 || index > len(d.recordOffsets) {
+		return errors.Errorf("the index %d is out of range %d", index, len(d.recordOffsets))
+	}
+	if d.recordReader == nil {
+		if err := d.loadRecord(ctx); err != nil {
+			return err
+		}
+	}
+	if index == len(d.recordOffsets) {
+		_, err := d.recordReader.Seek(0, io.SeekEnd)
+		return err
+	}
+	_, err := d.recordReader.Seek(d.recordOffsets[index], io.SeekStart)
+	return err
+}
+
 func (d *ILSVRC2012ValidationRecordIO) Close() error {
 	if d.recordReader != nil {
 		d.recordReader.Close()
@@ -1,6 +1,7 @@
 package vision
 
 import (
+	"io"
 	"net/http"
 	"os"
 	"path"
@@ -9,6 +10,7 @@ import (
 
 	context "context"
 
+	"github.com/Unknwon/com"
 	"github.com/pkg/errors"
 	"github.com/rai-project/config"
 	"github.com/rai-project/dldataset"
@@ -26,11 +28,21 @@ type ILSVRC2012ValidationFolder struct {
 	filePaths []string
 	fileURLs  map[string]string
 	data      map[string]ILSVRC2012ValidationLabeledImage
+	cursor    int
 }
 
-// New ...
+// New returns an instance of the dataset with its own cursor. The file list is
+// never modified, so it is shared with d.
 func (d *ILSVRC2012ValidationFolder) New(ctx context.Context) (dldataset.Dataset, error) {
-	return iLSVRC2012ValidationFolder, nil
+	return &ILSVRC2012ValidationFolder{
+		base: base{
+			ctx:            ctx,
+			baseWorkingDir: d.baseWorkingDir,
+		},
+		baseURL:   d.baseURL,
+		filePaths: d.filePaths,
+		fileURLs:  d.fileURLs,
+	}, nil
 }
 
 func (d *ILSVRC2012ValidationFolder) Load(ctx context.Context) error {
@@ -38,9 +50,22 @@ func (d *ILSVRC2012ValidationFolder) Load(ctx context.Context) error {
 }
 
 func (d *ILSVRC2012ValidationFolder) workingDir() string {
-	category := strings.ToLower(d.Category())
-	name := strings.ToLower(d.Name())
-	return filepath.Join(d.baseWorkingDir, category, name)
+	return datasetWorkingDir(d.baseWorkingDir, d.CanonicalName())
+}
+
+// Classes ...
+func (d *ILSVRC2012ValidationFolder) Classes(ctx context.Context) ([]dldataset.Class, error) {
+	return synsetClasses.Classes(), nil
+}
+
+// LabelIndex ...
+func (d *ILSVRC2012ValidationFolder) LabelIndex(ctx context.Context, name string) (int, error) {
+	return synsetClasses.LabelIndex(name)
+}
+
+// LabelName ...
+func (d *ILSVRC2012ValidationFolder) LabelName(ctx context.Context, index int) (string, error) {
+	return synsetClasses.LabelName(index)
 }
 
 // Name ...
@@ -56,21 +81,46 @@ func (d *ILSVRC2012ValidationFolder) CanonicalName() string {
 	return key
 }
 
+// Info ...
+func (d *ILSVRC2012ValidationFolder) Info() dldataset.DatasetInfo {
+	return dldataset.DatasetInfo{
+		CanonicalName: d.CanonicalName(),
+		Category:      d.Category(),
+		Name:          d.Name(),
+		Task:          dldataset.ClassificationTask,
+		Splits: map[string]int{
+			"validation": len(d.filePaths),
+		},
+		NumClasses: len(synset),
+		URLs:       []string{d.baseURL},
+		License:    imagenetLicense,
+		Citation:   imagenetCitation,
+	}
+}
+
 // Download ...
 func (d *ILSVRC2012ValidationFolder) Download(ctx context.Context) error {
 	return nil
 }
 
+// Verify does nothing since the images are downloaded when they are read and no checksums are published for them
+func (d *ILSVRC2012ValidationFolder) Verify(ctx context.Context) error {
+	return nil
+}
+
 // List ...
-func (d *ILSVRC2012ValidationFolder) List(ctx context.Context) ([]string, error) {
-	return d.filePaths, nil
+func (d *ILSVRC2012ValidationFolder) List(ctx context.Context, opts ...dldataset.ListOption) ([]string, error) {
+	return dldataset.ApplyListOptions(d.filePaths, opts...)
 }
 
 // GetWithoutDownloadManager ...
 func (d *ILSVRC2012ValidationFolder) GetWithoutDownloadManager(ctx context.Context, name string) (dldataset.LabeledData, error) {
 	fileURL, ok := d.fileURLs[name]
 	if !ok {
-		return nil, errors.Errorf("the file path %v for the dataset %v was not found", name, d.CanonicalName())
+		return nil, errors.Wrapf(dldataset.ErrNotFound, "the file path %v for the dataset %v was not found", name, d.CanonicalName())
+	}
+	if dldataset.Config.Offline {
+		return nil, errors.Wrapf(dldataset.ErrOffline, "cannot get %v in offline mode", fileURL)
 	}
 	req, err := http.Get(fileURL)
 	if err != nil {
@@ -80,16 +130,17 @@ func (d *ILSVRC2012ValidationFolder) GetWithoutDownloadManager(ctx context.Conte
 
 	img, err := image.Read(req.Body)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to read image from %v", fileURL)
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "failed to read image from %v: %v", fileURL, err)
 	}
 
 	if _, ok := img.(*types.RGBImage); !ok {
-		return nil, errors.Wrapf(err, "failed to read rgb image from %v", fileURL)
+		return nil, errors.Wrapf(dldataset.ErrNotSupported, "failed to read rgb image from %v", fileURL)
 	}
 
-	label := path.Dir(name)
+	label := synsetLabel(path.Dir(name))
 
 	return &ILSVRC2012ValidationLabeledImage{
+		name:  name,
 		data:  img.(*types.RGBImage),
 		label: label,
 	}, nil
@@ -99,20 +150,25 @@ func (d *ILSVRC2012ValidationFolder) GetWithoutDownloadManager(ctx context.Conte
 func (d *ILSVRC2012ValidationFolder) Get(ctx context.Context, name string) (dldataset.LabeledData, error) {
 	fileURL, ok := d.fileURLs[name]
 	if !ok {
-		return nil, errors.Errorf("the file path %v for the dataset %v was not found", name, d.CanonicalName())
+		return nil, errors.Wrapf(dldataset.ErrNotFound, "the file path %v for the dataset %v was not found", name, d.CanonicalName())
 	}
 
 	workingDir := d.workingDir()
 	downloadedFileName := filepath.Join(workingDir, name)
-	downloadedFileName, _, err := downloadmanager.DownloadFile(
-		fileURL,
-		downloadedFileName,
-		downloadmanager.Context(ctx),
-		downloadmanager.Cache(true),
-		downloadmanager.CheckMD5Sum(false),
-	)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to download %v", fileURL)
+	if !com.IsFile(downloadedFileName) {
+		_, _, err := downloadFile(
+			ctx,
+			d.CanonicalName(),
+			name,
+			fileURL,
+			downloadedFileName,
+			dldataset.Checksum{},
+			downloadmanager.Cache(true),
+			downloadmanager.CheckMD5Sum(false),
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to download %v", fileURL)
+		}
 	}
 
 	f, err := os.Open(downloadedFileName)
@@ -124,23 +180,54 @@ func (d *ILSVRC2012ValidationFolder) Get(ctx context.Context, name string) (dlda
 
 	img, err := image.Read(f, image.Context(ctx))
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to read image from %v", fileURL)
+		return nil, errors.Wrapf(dldataset.ErrCorruptRecord, "failed to read image from %v: %v", fileURL, err)
 	}
 
 	if _, ok := img.(*types.RGBImage); !ok {
-		return nil, errors.Wrapf(err, "failed to read rgb image from %v", fileURL)
+		return nil, errors.Wrapf(dldataset.ErrNotSupported, "failed to read rgb image from %v", fileURL)
 	}
 
-	label := path.Dir(name)
+	label := synsetLabel(path.Dir(name))
 
 	return &ILSVRC2012ValidationLabeledImage{
+		name:  name,
 		data:  img.(*types.RGBImage),
 		label: label,
 	}, nil
 }
 
+// Next ...
 func (d *ILSVRC2012ValidationFolder) Next(ctx context.Context) (dldataset.LabeledData, error) {
-	return nil, errors.New("next iterator is not implemented for " + d.CanonicalName())
+	if d.cursor >= len(d.filePaths) {
+		return nil, io.EOF
+	}
+	data, err := d.Get(ctx, d.filePaths[d.cursor])
+	// move past the record even if it cannot be read, so that it can be skipped
+	d.cursor++
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Len ...
+func (d *ILSVRC2012ValidationFolder) Len(ctx context.Context) (int, error) {
+	return len(d.filePaths), nil
+}
+
+// Reset ...
+func (d *ILSVRC2012ValidationFolder) Reset(ctx context.Context) error {
+	d.cursor = 0
+	return nil
+}
+
+// Seek ...
+func (d *ILSVRC2012ValidationFolder) Seek(ctx context.Context, index int) error {
+	if index < 0 || index > len(d.filePaths) {
+		return errors.Errorf("the index %d is out of range %d", index, len(d.filePaths))
+	}
+	d.cursor = index
+	return nil
 }
 
 // Close ...
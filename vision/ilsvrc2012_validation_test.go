@@ -1,6 +1,13 @@
 package vision
 
 import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	goimage "image"
+	"image/png"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	context "context"
@@ -32,10 +39,123 @@ func TestILSVRC2012Validation(t *testing.T) {
 	assert.Equal(t, "ILSVRC2012_val_00016503.JPEG", lst[0])
 	assert.Equal(t, "ILSVRC2012_val_00035805.JPEG", lst[1])
 
+	lbl, err := ilsvrc.Get(ctx, "ILSVRC2012_val_00035805.JPEG")
+	assert.NoError(t, err)
+	assert.NotNil(t, lbl)
+	assert.IsType(t, &iLSVRC2012ValidationRecordIOLabeledData{}, lbl)
+	assert.Equal(t, "ILSVRC2012_val_00035805.JPEG", lbl.Metadata().FileName)
+
 	for ii := 0; ii < len(lst); ii++ {
 		data, err := ilsvrc.Next(ctx)
 		assert.NoError(t, err)
 		assert.NotNil(t, data)
 		assert.IsType(t, &iLSVRC2012ValidationRecordIOLabeledData{}, data)
+		assert.NotEmpty(t, data.Metadata().ID)
+	}
+}
+
+// TestILSVRC2012ValidationRecordIOInfo ...
+func TestILSVRC2012ValidationRecordIOInfo(t *testing.T) {
+	info := iLSVRC2012Validation224CenterCrop875RecordIO.Info()
+	assert.Equal(t, []int{224, 224, 3}, info.ImageSize)
+	assert.Equal(t, []string{"center_crop_875", "resize_224"}, info.Preprocessing)
+
+	info = iLSVRC2012ValidationRecordIO.Info()
+	assert.Empty(t, info.ImageSize)
+	assert.Equal(t, []string{"resize_shorter_side_256"}, info.Preprocessing)
+}
+
+// writeImageRecordIO writes a record the way im2rec does, with a zero image_id[1]
+func writeImageRecordIO(t *testing.T, buf *bytes.Buffer, label float32) {
+	img := goimage.NewRGBA(goimage.Rect(0, 0, 2, 2))
+	data := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(data, img))
+
+	length := uint32(24 + data.Len())
+	binary.Write(buf, binary.LittleEndian, uint32(0xced7230a))
+	binary.Write(buf, binary.LittleEndian, length)
+	binary.Write(buf, binary.LittleEndian, uint32(0))
+	binary.Write(buf, binary.LittleEndian, label)
+	binary.Write(buf, binary.LittleEndian, uint64(0))
+	binary.Write(buf, binary.LittleEndian, uint64(0))
+	buf.Write(data.Bytes())
+	buf.Write(make([]byte, ((length+3)>>2<<2)-length))
+}
+
+// TestILSVRC2012ValidationRecordIOFileNames ...
+func TestILSVRC2012ValidationRecordIOFileNames(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	d := &ILSVRC2012ValidationRecordIO{
+		base: base{
+			ctx:            ctx,
+			baseWorkingDir: dir,
+		},
+		listFileName:   "val.lst",
+		indexFileName:  "val.idx",
+		recordFileName: "val.rec",
+	}
+	assert.NoError(t, os.MkdirAll(d.workingDir(), os.ModePerm))
+
+	// the list is not in the order of the records
+	fileNames := []string{"ILSVRC2012_val_00000003.JPEG", "ILSVRC2012_val_00000001.JPEG", "ILSVRC2012_val_00000002.JPEG"}
+	rec, idx, lst := &bytes.Buffer{}, &bytes.Buffer{}, &bytes.Buffer{}
+	for ii := range fileNames {
+		fmt.Fprintf(idx, "%d\t%d\n", ii, rec.Len())
+		writeImageRecordIO(t, rec, float32(ii))
+	}
+	for _, ii := range []int{2, 0, 1} {
+		fmt.Fprintf(lst, "%d\t%d\t%s\n", ii, ii, fileNames[ii])
+	}
+	assert.NoError(t, ioutil.WriteFile(d.filePath("val.rec"), rec.Bytes(), 0644))
+	assert.NoError(t, ioutil.WriteFile(d.filePath("val.idx"), idx.Bytes(), 0644))
+	assert.NoError(t, ioutil.WriteFile(d.filePath("val.lst"), lst.Bytes(), 0644))
+
+	assert.NoError(t, d.Load(ctx))
+	defer d.Close()
+	names, err := d.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, fileNames, names)
+	for ii, fileName := range fileNames {
+		data, err := d.Next(ctx)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, fileName, data.Metadata().FileName)
+		assert.Equal(t, float32(ii), data.(*iLSVRC2012ValidationRecordIOLabeledData).LabelIndex)
+
+		// the label of the record is the name of its class
+		name, err := d.LabelName(ctx, ii)
+		assert.NoError(t, err)
+		assert.Equal(t, name, data.Label())
+		index, err := d.LabelIndex(ctx, data.Label())
+		assert.NoError(t, err)
+		assert.Equal(t, ii, index)
 	}
 }
+
+// TestSynsetClasses ...
+func TestSynsetClasses(t *testing.T) {
+	classes := synsetClasses.Classes()
+	assert.Len(t, classes, 1000)
+	for ii, class := range classes {
+		assert.Equal(t, ii, class.Index)
+		name, err := synsetClasses.LabelName(ii)
+		assert.NoError(t, err)
+		assert.Equal(t, synset[ii], name)
+		index, err := synsetClasses.LabelIndex(name)
+		assert.NoError(t, err)
+		assert.Equal(t, ii, index)
+	}
+
+	// the names of the two crane classes differ
+	assert.Equal(t, "n02012849 crane", classes[134].Name)
+	assert.Equal(t, "n03126707 crane", classes[517].Name)
+
+	// the folder dataset labels its images with the synset id of their directory
+	assert.Equal(t, synset[0], synsetLabel("n01440764"))
+	assert.Equal(t, "unknown", synsetLabel("unknown"))
+}
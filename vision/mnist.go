@@ -2,6 +2,7 @@ package vision
 
 import (
 	"image"
+	"io"
 	"path"
 	"strconv"
 	"strings"
@@ -22,12 +23,21 @@ type MNIST struct {
 	base
 	trainingData mnistLoader.DataSet
 	testData     mnistLoader.DataSet
+	names        []string
+	cursor       int
 }
 
 var mnist *MNIST
 
+// mnistDiskSize is the number of bytes of the compressed files of the dataset, which are
+// embedded in the binary rather than downloaded
+const mnistDiskSize = 9912422 + 28881 + 1648877 + 4542
+
+var mnistClasses = labelClasses([]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"})
+
 // MNISTLabeledImage ...
 type MNISTLabeledImage struct {
+	name  string
 	label string
 	data  *types.RGBImage
 }
@@ -54,6 +64,26 @@ func (l MNISTLabeledImage) Data() (interface{}, error) {
 	return l.data, nil
 }
 
+// Metadata ...
+func (l MNISTLabeledImage) Metadata() dldataset.Metadata {
+	return imageMetadata(l.name, l.data)
+}
+
+// Classes ...
+func (d *MNIST) Classes(ctx context.Context) ([]dldataset.Class, error) {
+	return mnistClasses.Classes(), nil
+}
+
+// LabelIndex ...
+func (d *MNIST) LabelIndex(ctx context.Context, name string) (int, error) {
+	return mnistClasses.LabelIndex(name)
+}
+
+// LabelName ...
+func (d *MNIST) LabelName(ctx context.Context, index int) (string, error) {
+	return mnistClasses.LabelName(index)
+}
+
 // Name ...
 func (*MNIST) Name() string {
 	return "MNIST"
@@ -67,9 +97,35 @@ func (d *MNIST) CanonicalName() string {
 	return key
 }
 
-// New ...
+// Info ...
+func (d *MNIST) Info() dldataset.DatasetInfo {
+	return dldataset.DatasetInfo{
+		CanonicalName: d.CanonicalName(),
+		Category:      d.Category(),
+		Name:          d.Name(),
+		Task:          dldataset.ClassificationTask,
+		Splits: map[string]int{
+			"train": len(d.trainingData.Samples),
+			"test":  len(d.testData.Samples),
+		},
+		NumClasses: 10,
+		ImageSize:  []int{28, 28, 3},
+		DiskSize:   mnistDiskSize,
+		Citation:   "Yann LeCun, Leon Bottou, Yoshua Bengio and Patrick Haffner. Gradient-Based Learning Applied to Document Recognition. Proceedings of the IEEE, 1998.",
+	}
+}
+
+// New returns an instance of the dataset with its own cursor. The samples are
+// never modified, so they are shared with d.
 func (d *MNIST) New(ctx context.Context) (dldataset.Dataset, error) {
-	return mnist, nil
+	return &MNIST{
+		base: base{
+			ctx:            ctx,
+			baseWorkingDir: d.baseWorkingDir,
+		},
+		trainingData: d.trainingData,
+		testData:     d.testData,
+	}, nil
 }
 
 func (d *MNIST) Load(ctx context.Context) error {
@@ -81,36 +137,45 @@ func (d *MNIST) Download(ctx context.Context) error {
 	return nil
 }
 
+// Verify does nothing since the dataset is embedded in the binary
+func (d *MNIST) Verify(ctx context.Context) error {
+	return nil
+}
+
 // List ...
-func (d *MNIST) List(ctx context.Context) ([]string, error) {
-	lst := []string{}
-	for ii := range d.trainingData.Samples {
-		lst = append(lst, "train/"+strconv.Itoa(ii))
-	}
-	for ii := range d.testData.Samples {
-		lst = append(lst, "test/"+strconv.Itoa(ii))
+func (d *MNIST) List(ctx context.Context, opts ...dldataset.ListOption) ([]string, error) {
+	if d.names == nil {
+		lst := make([]string, 0, len(d.trainingData.Samples)+len(d.testData.Samples))
+		for ii := range d.trainingData.Samples {
+			lst = append(lst, "train/"+strconv.Itoa(ii))
+		}
+		for ii := range d.testData.Samples {
+			lst = append(lst, "test/"+strconv.Itoa(ii))
+		}
+		d.names = lst
 	}
-	return lst, nil
+	return dldataset.ApplyListOptions(d.names, opts...)
 }
 
 // Get ...
 func (d *MNIST) Get(ctx context.Context, name string) (dldataset.LabeledData, error) {
 	var dataset mnistLoader.DataSet
+	var index string
 	if strings.HasPrefix(name, "train/") {
-		name = strings.TrimPrefix(name, "train/")
+		index = strings.TrimPrefix(name, "train/")
 		dataset = d.trainingData
 	} else if strings.HasPrefix(name, "test/") {
-		name = strings.TrimPrefix(name, "test/")
-		dataset = d.trainingData
+		index = strings.TrimPrefix(name, "test/")
+		dataset = d.testData
 	} else {
-		return nil, errors.Errorf("cannot find %s in the mnist dataset", name)
+		return nil, errors.Wrapf(dldataset.ErrNotFound, "cannot find %s in the mnist dataset", name)
 	}
-	idx, err := strconv.Atoi(name)
+	idx, err := strconv.Atoi(index)
 	if err != nil {
-		return nil, errors.Errorf("expecting an integer, but got %s", name)
+		return nil, errors.Wrapf(dldataset.ErrNotFound, "expecting an integer, but got %s", index)
 	}
-	if idx >= len(dataset.Samples) {
-		return nil, errors.Errorf("the index %d is out of range %d", idx, len(dataset.Samples))
+	if idx < 0 || idx >= len(dataset.Samples) {
+		return nil, errors.Wrapf(dldataset.ErrNotFound, "the index %d is out of range %d", idx, len(dataset.Samples))
 	}
 
 	elem := dataset.Samples[idx]
@@ -131,13 +196,50 @@ func (d *MNIST) Get(ctx context.Context, name string) (dldataset.LabeledData, er
 	}
 
 	return &MNISTLabeledImage{
+		name:  name,
 		data:  img,
 		label: strconv.Itoa(elem.Label),
 	}, nil
 }
 
+// Next ...
 func (d *MNIST) Next(ctx context.Context) (dldataset.LabeledData, error) {
-	return nil, errors.New("next iterator is not implemented for " + d.CanonicalName())
+	numTraining := len(d.trainingData.Samples)
+	if d.cursor >= numTraining+len(d.testData.Samples) {
+		return nil, io.EOF
+	}
+	name := "train/" + strconv.Itoa(d.cursor)
+	if d.cursor >= numTraining {
+		name = "test/" + strconv.Itoa(d.cursor-numTraining)
+	}
+	data, err := d.Get(ctx, name)
+	// move past the record even if it cannot be read, so that it can be skipped
+	d.cursor++
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Len ...
+func (d *MNIST) Len(ctx context.Context) (int, error) {
+	return len(d.trainingData.Samples) + len(d.testData.Samples), nil
+}
+
+// Reset ...
+func (d *MNIST) Reset(ctx context.Context) error {
+	d.cursor = 0
+	return nil
+}
+
+// Seek ...
+func (d *MNIST) Seek(ctx context.Context, index int) error {
+	length, _ := d.Len(ctx)
+	if index < 0 || index > length {
+		return errors.Errorf("the index %d is out of range %d", index, length)
+	}
+	d.cursor = index
+	return nil
 }
 
 // Close ...
@@ -151,7 +253,7 @@ func init() {
 			base: base{
 				ctx: context.Background(),
 			},
-			trainingData: mnistLoader.LoadTestingDataSet(),
+			trainingData: mnistLoader.LoadTrainingDataSet(),
 			testData:     mnistLoader.LoadTestingDataSet(),
 		}
 		dldataset.Register(mnist)
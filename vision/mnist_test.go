@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	"github.com/rai-project/dldataset"
+	"github.com/rai-project/image/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,3 +38,96 @@ func TestDownloadMNIST(t *testing.T) {
 	// pp.Println(lbl)
 
 }
+
+// TestNewMNIST ...
+func TestNewMNIST(t *testing.T) {
+	ctx := context.Background()
+
+	MNIST, err := dldataset.Get("vision", "mnist")
+	assert.NoError(t, err)
+
+	first, err := MNIST.New(ctx)
+	assert.NoError(t, err)
+	defer first.Close()
+
+	second, err := MNIST.New(ctx)
+	assert.NoError(t, err)
+	defer second.Close()
+
+	assert.False(t, first == second)
+
+	_, err = first.Next(ctx)
+	assert.NoError(t, err)
+	_, err = first.Next(ctx)
+	assert.NoError(t, err)
+
+	fileList, err := first.List(ctx)
+	assert.NoError(t, err)
+
+	nxt, err := second.Next(ctx)
+	assert.NoError(t, err)
+	expected, err := second.Get(ctx, fileList[0])
+	assert.NoError(t, err)
+	assert.Equal(t, expected, nxt)
+}
+
+// TestMNISTClasses ...
+func TestMNISTClasses(t *testing.T) {
+	ctx := context.Background()
+
+	MNIST, err := dldataset.Get("vision", "mnist")
+	assert.NoError(t, err)
+
+	classes, err := MNIST.Classes(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, classes, 10)
+
+	index, err := MNIST.LabelIndex(ctx, "7")
+	assert.NoError(t, err)
+	assert.Equal(t, 7, index)
+
+	name, err := MNIST.LabelName(ctx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "3", name)
+}
+
+// TestMNISTInfo ...
+func TestMNISTInfo(t *testing.T) {
+	MNIST, err := dldataset.Get("vision", "mnist")
+	assert.NoError(t, err)
+
+	info := MNIST.Info()
+	assert.Equal(t, 60000, info.Splits["train"])
+	assert.Equal(t, 10000, info.Splits["test"])
+}
+
+// TestMNISTGetNotFound ...
+func TestMNISTGetNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	MNIST, err := dldataset.Get("vision", "mnist")
+	assert.NoError(t, err)
+
+	for _, name := range []string{"train/-1", "test/10000", "train/first", "validation/1"} {
+		_, err := MNIST.Get(ctx, name)
+		assert.True(t, dldataset.IsNotFound(err), name)
+	}
+}
+
+// TestMNISTImageSize ...
+func TestMNISTImageSize(t *testing.T) {
+	ctx := context.Background()
+
+	MNIST, err := dldataset.Get("vision", "mnist")
+	assert.NoError(t, err)
+
+	lbl, err := MNIST.Get(ctx, "test/1")
+	if !assert.NoError(t, err) {
+		return
+	}
+	data, err := lbl.Data()
+	assert.NoError(t, err)
+	img := data.(*types.RGBImage)
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	assert.Equal(t, []int{width, height, len(img.Pix) / (width * height)}, MNIST.Info().ImageSize)
+}
@@ -3,6 +3,8 @@ package vision
 import (
 	context "context"
 	"fmt"
+	"io"
+	"os"
 	"path"
 	"path/filepath"
 	"strings"
@@ -16,7 +18,6 @@ import (
 	"github.com/rai-project/dldataset/vision/support/object_detection"
 	"github.com/rai-project/dlframework"
 	"github.com/rai-project/dlframework/framework/feature"
-	"github.com/rai-project/downloadmanager"
 	"github.com/rai-project/image/types"
 	protobuf "github.com/ubccr/terf/protobuf"
 )
@@ -30,7 +31,7 @@ type PascalLabeledImage struct {
 	sha256    string
 	difficult []int64
 	truncated []int64
-	pose      []byte
+	pose      []string
 	features  []*dlframework.Feature
 	data      *types.RGBImage
 }
@@ -42,7 +43,9 @@ type PascalValidationTFRecord struct {
 	baseURL        string
 	recordFileName string
 	md5sum         string
+	numExamples    int
 	labelMap       *object_detection.StringIntLabelMap
+	classes        *dldataset.ClassMap
 	recordReader   *reader.TFRecordReader
 }
 
@@ -51,7 +54,9 @@ var (
 	Pascal2012ValidationTFRecord *PascalValidationTFRecord
 )
 
-func NewPascalLabeledImageFromRecord(rec *protobuf.Example) *PascalLabeledImage {
+// NewPascalLabeledImageFromRecord decodes the record. It returns an error wrapping
+// dldataset.ErrCorruptRecord if the image cannot be decoded.
+func NewPascalLabeledImageFromRecord(rec *protobuf.Example) (*PascalLabeledImage, error) {
 	height := tfrecord.FeatureInt64(rec, "image/height")
 	width := tfrecord.FeatureInt64(rec, "image/width")
 	fileName := tfrecord.FeatureString(rec, "image/filename")
@@ -60,7 +65,7 @@ func NewPascalLabeledImageFromRecord(rec *protobuf.Example) *PascalLabeledImage
 	imgFormat := tfrecord.FeatureString(rec, "image/format")
 	img, err := getImageRecord(tfrecord.FeatureBytes(rec, "image/encoded"), imgFormat)
 	if err != nil {
-		panic(err)
+		return nil, errors.Wrapf(err, "cannot decode the image %v", fileName)
 	}
 	bboxXmin := tfrecord.FeatureFloat32Slice(rec, "image/object/bbox/xmin")
 	bboxXmax := tfrecord.FeatureFloat32Slice(rec, "image/object/bbox/xmax")
@@ -70,9 +75,22 @@ func NewPascalLabeledImageFromRecord(rec *protobuf.Example) *PascalLabeledImage
 	classesLabels := tfrecord.FeatureInt64Slice(rec, "image/object/class/label")
 	difficult := tfrecord.FeatureInt64Slice(rec, "image/object/difficult")
 	truncated := tfrecord.FeatureInt64Slice(rec, "image/object/truncated")
-	pose := tfrecord.FeatureBytes(rec, "image/object/view")
+	pose := tfrecord.FeatureStringSlice(rec, "image/object/view")
 
 	numBBoxes := len(bboxXmax)
+	err = checkObjectCount(fileName, numBBoxes, map[string]int{
+		"image/object/bbox/xmin":   len(bboxXmin),
+		"image/object/bbox/ymin":   len(bboxYmin),
+		"image/object/bbox/ymax":   len(bboxYmax),
+		"image/object/class/text":  len(classText),
+		"image/object/class/label": len(classesLabels),
+		"image/object/difficult":   len(difficult),
+		"image/object/truncated":   len(truncated),
+		"image/object/view":        len(pose),
+	})
+	if err != nil {
+		return nil, err
+	}
 	features := make([]*dlframework.Feature, numBBoxes)
 	for ii := 0; ii < numBBoxes; ii++ {
 		features[ii] = feature.New(
@@ -100,7 +118,7 @@ func NewPascalLabeledImageFromRecord(rec *protobuf.Example) *PascalLabeledImage
 		pose:      pose,
 		features:  features,
 		data:      img,
-	}
+	}, nil
 }
 
 // Label ...
@@ -113,6 +131,18 @@ func (l *PascalLabeledImage) Data() (interface{}, error) {
 	return l.data, nil
 }
 
+// Metadata ...
+func (l *PascalLabeledImage) Metadata() dldataset.Metadata {
+	return dldataset.Metadata{
+		ID:       l.fileName,
+		SourceID: l.sourceID,
+		FileName: l.fileName,
+		Width:    int(l.width),
+		Height:   int(l.height),
+		SHA256:   l.sha256,
+	}
+}
+
 // Feature ...
 func (d *PascalLabeledImage) Feature() *dlframework.Feature {
 	return d.features[0]
@@ -136,57 +166,160 @@ func (d *PascalValidationTFRecord) CanonicalName() string {
 	return key
 }
 
+// Info ...
+func (d *PascalValidationTFRecord) Info() dldataset.DatasetInfo {
+	return dldataset.DatasetInfo{
+		CanonicalName: d.CanonicalName(),
+		Category:      d.Category(),
+		Name:          d.Name(),
+		Task:          dldataset.DetectionTask,
+		Splits: map[string]int{
+			"validation": d.numExamples,
+		},
+		NumClasses: len(d.labelMap.Item),
+		DiskSize:   d.datasetFiles().DiskSize(),
+		URLs:       []string{urlJoin(d.baseURL, d.recordFileName)},
+		License:    "images under the Flickr terms of use",
+		Citation:   "Mark Everingham et al. The PASCAL Visual Object Classes (VOC) Challenge. International Journal of Computer Vision, 2010.",
+	}
+}
+
 func (d *PascalValidationTFRecord) workingDir() string {
-	category := strings.ToLower(d.Category())
-	name := strings.ToLower(d.Name())
-	return filepath.Join(d.baseWorkingDir, category, name)
+	return datasetWorkingDir(d.baseWorkingDir, d.CanonicalName())
+}
+
+func (d *PascalValidationTFRecord) filePath(fileName string) string {
+	return datasetFilePath(d.workingDir(), d.CanonicalName(), fileName)
 }
 
 // Download ...
 func (d *PascalValidationTFRecord) Download(ctx context.Context) error {
-	workingDir := d.workingDir()
+	return dldataset.DownloadFiles(ctx, d.datasetFiles(), d.Verify, d.download)
+}
+
+// download downloads the record file
+func (d *PascalValidationTFRecord) download(ctx context.Context, verifyErr error) error {
 	fileName := d.recordFileName
-	downloadedFileName := filepath.Join(workingDir, fileName)
+	downloadedFileName := d.filePath(fileName)
+	// a partial or corrupt file from an interrupted download is downloaded again
 	if com.IsFile(downloadedFileName) {
-		return nil
+		if err := os.Remove(downloadedFileName); err != nil {
+			return errors.Wrapf(err, "cannot remove %v", downloadedFileName)
+		}
 	}
-	downloadedFileName, _, err := downloadmanager.DownloadFile(
-		urlJoin(d.baseURL, fileName),
-		downloadedFileName,
-		downloadmanager.Context(ctx),
-	)
+	_, _, err := downloadFile(ctx, d.CanonicalName(), fileName, urlJoin(d.baseURL, fileName), downloadedFileName, dldataset.Checksum{MD5: d.md5sum})
 	if err != nil {
 		return errors.Wrapf(err, "failed to download %v", fileName)
 	}
 	return nil
 }
 
-// New ...
+func (d *PascalValidationTFRecord) datasetFiles() dldataset.DatasetFiles {
+	return newDatasetFiles(d.workingDir(), d.CanonicalName(), map[string]string{
+		d.recordFileName: dldataset.MirrorURL(d.CanonicalName(), d.recordFileName, urlJoin(d.baseURL, d.recordFileName)),
+	})
+}
+
+// Verify checks the record file against the checksum of the manifest, or against its
+// md5 sum if it was not recorded in a manifest
+func (d *PascalValidationTFRecord) Verify(ctx context.Context) error {
+	if ok, err := d.datasetFiles().VerifyManifest(); ok || err != nil {
+		return err
+	}
+	return verifyFiles(d.workingDir(), d.CanonicalName(), map[string]dldataset.Checksum{
+		d.recordFileName: {MD5: d.md5sum},
+	})
+}
+
+// Classes ...
+func (d *PascalValidationTFRecord) Classes(ctx context.Context) ([]dldataset.Class, error) {
+	return d.classes.Classes(), nil
+}
+
+// LabelIndex ...
+func (d *PascalValidationTFRecord) LabelIndex(ctx context.Context, name string) (int, error) {
+	return d.classes.LabelIndex(name)
+}
+
+// LabelName ...
+func (d *PascalValidationTFRecord) LabelName(ctx context.Context, index int) (string, error) {
+	return d.classes.LabelName(index)
+}
+
+// New returns an instance of the dataset with its own record reader
 func (d *PascalValidationTFRecord) New(ctx context.Context) (dldataset.Dataset, error) {
-	return nil, nil
+	return &PascalValidationTFRecord{
+		base: base{
+			ctx:            ctx,
+			baseWorkingDir: d.baseWorkingDir,
+		},
+		name:           d.name,
+		baseURL:        d.baseURL,
+		recordFileName: d.recordFileName,
+		md5sum:         d.md5sum,
+		numExamples:    d.numExamples,
+		labelMap:       d.labelMap,
+		classes:        d.classes,
+	}, nil
 }
 
-// Get ...
+// Get returns the record whose image/source_id or image/filename matches the name
 func (d *PascalValidationTFRecord) Get(ctx context.Context, name string) (dldataset.LabeledData, error) {
-	return nil, errors.New("get is not implemented for " + d.CanonicalName())
+	idx, err := d.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	offset, ok := idx.Lookup(name)
+	if !ok {
+		return nil, errors.Wrapf(dldataset.ErrNotFound, "unable to find %s in the %s dataset", name, d.CanonicalName())
+	}
+	rec, err := d.recordReader.ReadRecordAt(ctx, offset)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v from the %v dataset", name, d.CanonicalName())
+	}
+
+	data, err := NewPascalLabeledImageFromRecord(rec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %v from the %v dataset", name, d.CanonicalName())
+	}
+	return data, nil
+}
+
+// List returns the image/filename of each record in file order
+func (d *PascalValidationTFRecord) List(ctx context.Context, opts ...dldataset.ListOption) ([]string, error) {
+	idx, err := d.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, idx.Len())
+	for ii, entry := range idx.Entries {
+		files[ii] = entry.FileName
+	}
+	return dldataset.ApplyListOptions(files, opts...)
 }
 
-// List ...
-func (d *PascalValidationTFRecord) List(ctx context.Context) ([]string, error) {
-	return nil, errors.New("list is not implemented for " + d.CanonicalName())
+func (d *PascalValidationTFRecord) index(ctx context.Context) (*reader.TFRecordIndex, error) {
+	if d.recordReader == nil {
+		if err := d.loadRecord(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return d.recordReader.Index(ctx)
 }
 
 func (d *PascalValidationTFRecord) loadRecord(ctx context.Context) error {
-	workingDir := d.workingDir()
-	recordFileName := filepath.Join(workingDir, d.recordFileName)
+	recordFileName := d.filePath(d.recordFileName)
 	if !com.IsFile(recordFileName) {
-		return errors.Errorf("unable to find the record file in %v make sure to download the dataset first", recordFileName)
+		return errors.Wrapf(dldataset.ErrNotDownloaded, "unable to find the record file in %v make sure to download the dataset first", recordFileName)
 	}
 
-	recordIOReader, err := reader.NewTFRecordReader(recordFileName)
+	recordIOReader, err := reader.NewTFRecordReader(recordFileName, readerOptions()...)
 	if err != nil {
 		return errors.Wrapf(err, "failed to load record from %v", recordFileName)
 	}
+	if d.recordReader != nil {
+		d.recordReader.Close()
+	}
 	d.recordReader = recordIOReader
 	return nil
 }
@@ -198,12 +331,49 @@ func (d *PascalValidationTFRecord) Load(ctx context.Context) error {
 
 // Next ...
 func (d *PascalValidationTFRecord) Next(ctx context.Context) (dldataset.LabeledData, error) {
-	rec, err := d.recordReader.NextRecord(ctx)
+	rec, err := d.recordReader.NextDecoded(ctx, func(ctx context.Context, rec *protobuf.Example) (interface{}, error) {
+		return NewPascalLabeledImageFromRecord(rec)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return NewPascalLabeledImageFromRecord(rec), nil
+	return rec.(dldataset.LabeledData), nil
+}
+
+// Len ...
+func (d *PascalValidationTFRecord) Len(ctx context.Context) (int, error) {
+	idx, err := d.index(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return idx.Len(), nil
+}
+
+// Reset ...
+func (d *PascalValidationTFRecord) Reset(ctx context.Context) error {
+	if d.recordReader == nil {
+		return d.loadRecord(ctx)
+	}
+	_, err := d.recordReader.Seek(0, io.SeekStart)
+	return err
+}
+
+// Seek ...
+func (d *PascalValidationTFRecord) Seek(ctx context.Context, index int) error {
+	idx, err := d.index(ctx)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index > idx.Len() {
+		return errors.Errorf("the index %d is out of range %d", index, idx.Len())
+	}
+	if index == idx.Len() {
+		_, err = d.recordReader.Seek(0, io.SeekEnd)
+		return err
+	}
+	_, err = d.recordReader.Seek(idx.Entries[index].Offset, io.SeekStart)
+	return err
 }
 
 // Close ...
@@ -232,9 +402,11 @@ func init() {
 			},
 			name:           "Pascal2007",
 			labelMap:       labelMap,
+			classes:        labelMapClasses(labelMap),
 			baseURL:        baseURLPrefix + "/pascal2007",
 			recordFileName: "validation.tfrecord",
 			md5sum:         "e646ecf0bf838fa39d34e58d87c3e914",
+			numExamples:    2510,
 		}
 
 		Pascal2012ValidationTFRecord = &PascalValidationTFRecord{
@@ -244,9 +416,11 @@ func init() {
 			},
 			name:           "Pascal2012",
 			labelMap:       labelMap,
+			classes:        labelMapClasses(labelMap),
 			baseURL:        baseURLPrefix + "/pascal2012",
 			recordFileName: "validation.tfrecord",
 			md5sum:         "9a59d26492103b8635ba0c916d68535a",
+			numExamples:    5823,
 		}
 
 		dldataset.Register(Pascal2007ValidationTFRecord)
@@ -3856,6 +3856,426 @@ ghxGoh9Ma6ap2ltMngZ0GT1k6/z/I5L/CwAA//9lIracMMEdAA==
 `,
 	},
 
+	"/vision/support/mscoco_minival_ids.txt": {
+		local:   "vision/support/mscoco_minival_ids.txt",
+		size:    54864,
+		modtime: 1561861628,
+		compressed: `
+H4sIAAAAAAAC/zRdWbLlKgz712osyeP+N9Z1yO33qvrWSQIYMJ5tVHENFVcJl2lUdpAoJbehqO1GkRmG
+6WMjJ4dQbWshuzpQ08FBRtbvDyOqwdm+gHJv49fJKJG64YLtmIYySYLq6YZprsDjLUHfnWCVPL9eqIOY
+xQOn0os8WwQnGUa1p4VyzxW0dx3I8eg3elUGdCIX7tgi1MMplLXzA5dNo5bJguqWh0xuEl726veuBvmb
+To5Ph4lW4zxjaJ0U3NOFvAoFNJtBWOcY8LQM5HE2UdHJhlR5hSpPDrYzBaUUC1q9DW/tDHShXJRqolA5
+jdwuEbaKAr1VDefGGtr0FDKCC13fJby9hbRSB64zBul0LXLETpQjL1ETfQbPD/xTU7jdETLrt1llKQ63
+lqAtMqGeUaHYUTBzf43IH7SuikZlqBOechKZt1popuvQezamdwxxqhKi6wRezPSvWymRkzkHp+sONbs0
+qO0+VLZOyCl3wTOt/r1bG6mJIypHGpCTwoZo5K9fwpM1CYo1go5F0HuCWr6Ew90D3o4SYwUyN38D8mID
+2eE55Pr6t6NdbNDHJcgwHDssKKZiIHr1+9OxRmbVDZSh+cF+tQWyvQd6qUTVRRFW7+1vUG+jflAFipcd
+kPcMZdaiuIxDtm4FFvOEYqoSyhkwhz9AJ3yJyv1trCp+4LjTSbh4PfC2ZmBtbKJI3SHvnAezKgg5f7NO
+bbUg3gyhXVaA7hDB3uZBt2Zjilxwb0pQemuga0moygih7NbCm3UNhW6JvHUFfMw2uL4jShstZPEH3+wP
+uthywrpwwNz6TU55gpvagtT3kM2uRnLUBUa1BDbZBzVzB+zJGcj2BJi6O6h6aDhqbTBcc3CpvKBr2vCJ
+mWhyCUU4hKy+WOh3FBec2wrkbkyBk0WCl1MFc1aCfq0cbw04qmpU7kOSZM+iSnegczrg0vn3RVEHzwQF
+Jq0Fw1Pz+3490CobVLHfzk4WNPs68dBGhh/2Za8KFapaqOuxAe1tIU+RBOkMw6PwwNEMQzvvQO3kFXJu
+o2C348BWpHH1tspvtE7lgnlkQTnpxWQ4QEdnQatNgpo4gUHOR+/T4FznI9E28t5BneioB9vAXZqBgjeP
+EXQb4oiHbEuN5CmhJUNwzbKRFzsLRjiNUkUtaFcYyWYWdE4iWUmDqtKgYu5xGHXcO9OMH2yzhLPoQamd
+gno7+db/HbAIgp7ywm8hknW16Bkixe1C58XB6w5C0ReDVGwGPHEb0GYJNdwzWC4FuLUX8Ok+Ohg0VOM6
+aG7nkLPNBM3jIeM8kHYSHHsKjHYmMveNXBmEqZ1ARuw2XCwHnPnrnuOKQE6kDF+Pfv0q7zdm1z4qHTeP
+bfJQ3Hc62tsNWzqhZ6PAkkgUK1SQ6AqUSjmYzQtUpFO4+nWx6l6omr+xFJpAlaILSnkOm78xft0uIW12
+QjL1G7E876j2wr3OA4O39U4DUekqgjFV+/A+DndRCaorA9s7gzzNFnL1m2sNqxMVJBu63iEUvgKXxUX1
+vvMkshLV3AHv8fD7NYYZVsFnTUJ8PEtpSU/myUOWKIIVFQsnp/RrN/XYSwak/UFvV+2BCgVKV/eb8+0m
+nLm7kG7ox1z24ZbuW0RCzRj9GscEbEUP1A4m+DvWRhaHCd9YA8XjD0xnoCYv+9elupE75USelA11zhDz
+e4n03UAuKuFg/PqNiFzMvJ3YHMGmt0HPGkoVjeSxFnVvwdZhv3X7PdNFEsrTNLiaKbg4Z9xoYGf0ofqd
+u/K5fn10Cyk3BzlVb+3qhIpf98hW9SN1bIir2F/nbqFaDCKTmcJQPvjK8wNG7YGqf11sZgV2BA5zjdIo
+F5ZZBWVMJVhhvRlmwrSYUMVxYDsdUOptuH7fQGtegJe6efsfjaro42MqNEzxNxVH80B6H19wleBJsuBK
+F9ghH1oPwZM7Bq9r8vfst7o1ZCI1TUGp7YOrx4YjVwvlbiAvKg4ZE2tkzesxNLsod/IJv0ehMimiIryH
+LDIEigrkOqcxcTvI1dyhUpWHPLmRStUiXbP+9qOR3jijgquErM1CPcFNMm+h3bORFVm/X9Wdv3nmJjL2
+/ISuZUEXt4Rvqwdmzek3t95HYOnGVJNwKG9hi2WsdEayj0TVdiyqs+t+k+fVdzwCNRf58GACXWcwqxWo
+Ytcjr2uhWLMFFTMS2rEfFfitpp0RDXWT89jZ/bplLaz1DbLX9YMjMgLmZCGjbgLsaT5UYCHr6n57vFmD
+mhg3cvp8yFEzMGIVSNVvLH8Tiotf451epHMb1b9VRN5vMJjiQK3XH6VIMDUSWJSJ3Og0eKMs+La7kZnb
+BzLuDrxPy5yLp6kpFU8O8NMzrIODFwtfdSbK0ySY3B3kMA8ZTgvO5SPteYWeu4Q9eb/PtrahfLtfdrag
+ZfiPBhMsHgkNxULG7GMkMRGo2FqB4R3D5a7fu4slMjrDsNknqNPxRNIq1Kgd8HCJo93I/S0ozNUmyN/c
+YEcVVDpDOivhySjBmyQh+orwD8aCeVqjfFkG5zeUY28SViu/V29PZqdR6suAq50febEwnjJ06lyopU04
+8pSo2gfj5NZC5eLC5LzVv/71HL8P6W4l7HQU3HU3aLsKPEpv6LHgHmpRU7xFRXQNKtoDDldI5ZxQXS6j
+2pEBr/MSk1kLVjEF3lDvwG8NUqskMmO/ddYe0uO3IZF5T9MnVsNC3rUJL/Ubv9+ivsVMlLYjkJEaQxNV
+CcVGGdxtGrlPXk8r2KBuI3Cc+s3TTyTiBBe5oxUy82iwriJA9UqojOoEo5a/CcevuaPjDjlv0ShvCkWL
+B1Z+YsmEE1qfHtGJMOTtEHg5T5nV6J3zzkApPUZWvT3qi99ofuSuXfy9mREkzwzYLA6UfsNUZAbkuylk
+MmJh5bDAEqOhfLpYtjYKui4RSU3Pox00PMH+vVOpwRhlQpxf13YoElJwGjUzLvAmJFCTRRR3ghBlCYrc
+HWj6Gs7NSZAnG1rtry9HZCBVv8k+FOUPsNWDz2V46uJAmf6N6j3CXlWDt8qB/+TmYtRjX09/rnnKnCJn
+kXl70NobYF5EofQE1VaDw1s4rm2UUvkbn7UHS8NEVm0OlJu98PByH7pooUgvctnz63x4hat4qLOsQ5XZ
+BV9ZCR89xPb+wGxShHjjgXenYL9WntkluJUjuE4zIHOb8GzkguqHfiEOrFA2WKsBZ+VP9vs05ptFxjKI
+meZvlInMh0xMKOb6911mQe11gtUT+eDo38iXIfiu3q8NqOaSqI7+gX2RQyhO93u3XiG77UN1esCrSMGb
+ckBhMR/BHmgdXngt/6YwEyjLj+XXxUOE7N+v2RpYPfc2ou/gvgojr1qN7LQW2RJfg+EhnbH3kS0jp78R
+uCU4XF6UavOQ+0S85HUZ6oo2cq8axU1ojnmfovVorrpBZeoHCJWfLmowp6phX1GoeXRTu3UH1Q/yt02V
+8PkxWMlMaIe/EWmjMnoJRjxm0Zt1qAgyMG4nsuN+/YZ3FjX7KY/uECoUfHvWPxjDHYYUb3+m9AOgrScI
+djUydscge5ev5xHsdf1+zBJFycjONvZ8j+92NuzJ+r3hozitR+z7diDn7sHU70V1GsXJEbKYLih6I0CK
+WtiUG2w7GpfcQt4OG2WNBWY/kbaeLMjy/h5G3UNh+xaVfdMPrTqgnFaCfDqN0nkEK+d96Y5HFK4FV6n6
+HZh4KBpLeKQwtNlRKLYywYu8T0kIwupYoZPZyHgietKfieGKgexQEZd2/0AfETXOQir56TwViz5Xo/o3
+Jipu9h1jL0iWC076E5qiB66lAsp8ZHKjy09KFtJbHXBNJNET/QP+tOD8/kcp0k9jfxtpugr12R/18YO4
+ZkAtyz9wZxOZl1uPzeVb4vQiHTsE72oWxfbTtH0UnJtr5FbTYDozcHkSHqYNJrMGWetAt25QTI5xx31a
+vNyQ48LIkinkdGaieu87IUtCHlc8/qpElltCXtVBEx0H0hNEsnzG6TfK71EkuDtXYK42kLlk4vGRBNv8
+rQ53eBDPn6tEOXD7NOhyDXaagyo19dQtDqica+RMPp6ebRR9vdC4PXClKFzdD9JmNOEYdcFsPeJJp5Bt
+jcA+jeHr4uKsJmo0NjLG21Coa5HZeZ/pqgTpNIn89ZFwXsRnTjXy9ohuZoLZayJ7chM3t7+9ZOfAwbcO
+MSmCY/1mO1ObyOJl4O70m1G0H4W7JHLr14xTl09I7zFO14ab1u+LefrSmIHi/HbinPEbq7MWvnyksOcU
+YN89dK4/4c9CaaZ+C+x83CCftlczG5+njNieBve3eqgKzhu/q98+k9DSLJTT85tYTeCxjoQ/IpJmGOVV
+GqmKmd+rygJj+Yjqo9ZT0tNin1KYmsNcsuDMUP3AmBvI6TxInSNQbv9+/ZYA8twmvMOE5B7CtcoBS9mF
+EvMpfR4JbpL8jAO/1rUWHOck1Jt9PxhdRlnlX2fzg+vXegPpaS4oXRkTu3D3GdTzH6V58RDtHbypHwC9
+JKHQqVGRxUZV9RYsuQ/OaQXIkPkdWOE3RAZK9xh5hJNgLTswrl2Ql9O/yYsNVt4lVDdVEKkV0r4h1K+B
+7rIXNUEvKAcHruLnFuMIGS0WNn/TyN1MsLkK+Dr9aIVzISljUKaMPFb1210HMqM5yFzH26hrOHuTn7fp
+QFdMo9pqyKYPuZP89Tu/h/RMG157Dzn7OPjMfX6QEsgnnbJiwkiRcY9ulz8R76CMjUD3imBp93HNzkBO
+ZTccnDsweijkb0ML5fYEUuH9xJheaHkV4F4Y/mQDu3+P+iG6ghEJ7+l5Uofv87IGXKp+mz6+BlehQrM6
+4XgMx1Y+HfMyDG5mgvMmqkhFw5tdEOuiwXpnLLOCh19bDXSnGKROS9RkP6F/ogdK6uF2bAUqtFzUdPVj
+HjOFHUMbbj8m/6etZkHbXKHH+vWumcXjcAV7PmNpbxk5xR+gvPs0WCLD2/o9Swc8Mbfg2Ot3aH9jytfI
+zeiG85IJteRC/maS6JIXiksF7NY0smwtMkbbSEVewB2/h6zNjMfknci9nELOeA+64RMiHiGMWRu8vRPu
+xo3Kj2syj7/GqsnP0o52zoLHPqPZm0jqHryTsUhl5zvAI5gbG3DoB5kzH/M3fz8mw79G5pvIcxs0PeDu
+ZYFbVwH5Nh+6XSZ0URMoPYZYxSuhaN/8PtnvXXGgmciF6jlUuP25J3lpiE9wW8bnFuMlSj3yD27m/LoM
+DzhjL5ReEwrnLhSb92u32fHZmQzfcQYZ2b1gxzShIdOYjh3k7EnIjMhC5qWFZqhgk37nPnhgTs2gcu1B
+ZuoSWdttyL0e9Fb+3pwe1iwbxe78VJ/2D7hyonMliM4yuquJtLhva4OwtJnI298HuTu78D1epx9G/LqI
+XWTnRIPnUYB3wQG3U4/JzMIV3oZTduH3mRJVcT646hJeegW5LgR1OyB6nos+IgjqfqPUDPPXynENT+8R
+2rxDaiIJM68LMtlGjRbOWTe46V1o8kn2uR3I+ZAxaA7uKhvM6TJKVyyYPH3UdaCmUZdtpKPnSWOTiY6e
+ga3fdup6lHDZIhh2NNzeHUyniZSjFlQ4A+RlCQ/JsmUN2KY+O/vv4Z5X4Jr763B+m+CpjLeA0iId00JR
+2YuMo+rJNZmoOtKPNe3+5pVOeF2F1CO2ns8b++ehWf96rnG5UfvWJlUZCVfEJnIEbVmwbjiQQ4rvxDzE
+Yzdu0gkp6wo7uUax7xZm7jSsZqCY9fDjYasvk78pyA446s7wMO/Pbh/Q5cVCMWmimsGEujbh3h7Ck3eB
+yslBTg3fsVJC62lic1woiZrPymM8iaiRc10Hmq1BVtzvV9kRqI2/keeplWR96EdcVxQ062jo2FVgxqOw
+jkTV5GeWyQBrsv9sHgXy2A17h1BOajF1UR9TR1e4YLv+9p0Fr3saFaU0dj6sySK0IxcmeAFlRQ+SUwpI
+Jye4fmqhHVzUr79+ZJ5PBg0R1KqFpDI/b10aVRUVSHeRyCzZPxCf5FDtG5Se6l6i6zesI43UcA8ZbCYu
+V4aL/AznH0WXmXDUzv064Q06Mw55T7uXO5ugl1NIL/vxgUg4a0iMHrqqxwN35G+/qsuDVEcZv68TWl1A
+UuUinb9ZJ7dlKDsrQXKDoPL3hYY2so4ckGqBfY9S9901HPl4FD+iJK0EXnuIirwZ+MqdUPoxLra4SI5+
+XUbPd84uf98ziJwtCZ77liX9SFBoHovOxnEzwekwcasdaDaufiP/Jut0n1AyPZBCn8qWW/A0z8h40g+3
+ZgnPDmF3diLbJjKViQ3Nb6hgDtxkNLIf0S3Pvq8nax7LTkPH0kI7q32yfC68q03UdDwhkjeGKyghJZVR
+cUviNwsjGY/j2fFb26Wi4PRN4yIGp5zFQ+9EbjsaFb7fw/Ys7Krfj8gYQqqqhTgnf3L8gN6oH1C1LMiP
+aNgPhVmnjM/80pA6Y1Em1b92dYdmn7G3TegqEmzr09QvPjJ4UNXblcfpbuoE3w8aKLx5oE+5UFYXoamn
+oWTlbyR9CpboOhTnoanrj679RiF5C7sZxASdqKjMgbgffsbG57X+aF3yN+VOg9aEwZtj/ZrVW96rxt38
+vuMsD8o4LlixTijGv4GtXmFXD6f9W5Js1SaStgg3S0RexxLJqDUyI/RIkjbhm+iDNvIWOeZ83N4Hzc0k
+dJ5AcffuIUkP9odW7/Q6fo2Hgfp06dw5gzVTDS1Jghe9v1GYOU/Ar8+jfAn1Tgq8qzKk2FjQ41/P1RcG
++QXPdicbyXaBjN/qO+P8+QuCYPZzh3D69/0OH+9oftMlci+Y0CnbIF02klIO1L4xipWZcPrXzgxFQOeN
+hFS/2bPCj9v6mLiJwd7dgTOb+pwz+Z3AQ68j4HZXIrvukeu7Ppi+XJQ0cxBvf93eugTqMyb1aLDBBqd+
+QOQ9i75j9vQbpDSf92OhqK0EmeRBDhl+UMNrJ0Enn3PaJWEi1bCOXjDKv9lN1Q24M0dwLhPubhLnPEOp
+lJDcFKp2M2DpIfKTY5S6+Q04aujxJMg5l8hb6TeUfrunnetBX4go+vd5eq9RcamHrFSCvykIzrTq96cK
+mY8CybUDuS8S3GobztskPvmcVPWC27rG8JgPNNCV25A8OQ+D+AO6uIS6TCMj1f796kSxtz/H4DVsuf5C
+1Q6Z6fh9EjsDV3sH1RQTJnOIjJ2938PR4a14gJP9e1fDQLrohne3Fv7IW8WSKJNO1LgfHY4vLDtvF179
++rAm8p2/x3lz6EEG4/fnYkjknbqhPxPC72nBv94KjJETssxCtq4MlSLf2u3b8GDvp1chqyo+E20jOVeD
+7K15HNVfcF5vwlNMQ5yWnrYYhnP613GKK4je3yd3zIVLvy1Nd0+DzZ0CMz+3e04Scn1qDucOIiP1GE78
+NtJjIqkyId1kID3UIeV1oJobDXu6G/W5l71RgwlVIi99xvidolaqUF2+HwTlX097TcIzsb8OLytQefPF
+5F0G5HII9cUbhx+T38wDmTvxqb7wvdXKvPWDsGl4bnrgvrq3hMvPiciDdCahY09B+m0jkr1bsK4fmmQ0
+OPqCKDyF5X5pCLuNbNoJV4+gdKYgjvqQWfFZ6Eghq36DJOVN7F7/Fjj6E0plVGRM4jQFVlU+alKp3+hd
+C5sdAbPyBF4qFmw9EqZs5ufgHzDjByI/e0JGNxu5HXNwkyOo1tnIS+br05/dOphQqnlI+mHdNneQsY8v
+iLEH5+dCs8qf336IKtY9qE9v1BykSz9QyM+tdo/4egUyqgMq3SMq15dof6GIuWyMIhLsnF2k8/HcMuMT
+bGeh3tyELqYfzxXrSVIKWD2GZvKJHXccmLW9qFby4FnnwLftwWbcgIrSfLTdSDNHyAh5sZ1qKPZhvrJc
+kEQ/gvb7o478VuwMZffo92t8qDqVwLIzITc/MT0m4d/Pwqnfdm2Lv2fyIzTvBFwzCjV78esk4hbMevTG
+TBPmb6PQ++kac1q4VQpkz3xBUvNr3X7IP6M98OTWr0FPolT5A7bVg+y3WhnP+F/FjoWzxMfMuowqXeHm
+z1UQSVTUR+IYK9DyFFSehX47+jlZo0C5SDB3/FwnQyHPU4TuEa5ePVVssxZ5jHrIdtPgMkUorrfAnMpC
+bn+nNooN9na/k5INX90NlDryIS4Jc8IFV5YGdU0UPWdQ6Qt4gjL4Wd94n9ZeWhpWHw9pJRMZJx/yN3mC
+rO6BKH3in+bgqapEZbf0G5u7UMdyoe3Qo4KfE+F+K680c0ErPKiOViLrRgTPeQupZaiSnSD1ZRA5J9Dq
+E6S5DOTQLuQXGEV3Hji3t2BoPcjJmEVmzl8o85OF8gSuTkaKfLOpvgLjy8HYmH0scPIwXTRqto6w+/eh
+cvIheeQFlB8mm58ndDKe2iKjGON6pzn5lv4TgmOfAL2fH9OJ6nknxckTcvUFNpSVqEx9pv4n/PSdGg7W
+JPK0+4Pc2fqE2rfhtQXxPreeewtZ11mY1pv+1nzhTGsoZrSfwythRp6h0ds99uUXIZJftEFB4yfhVz6L
+50bfQj3dCV1NH1KeDEjZhNQ9qNhMwxcZhPvuHYRsDXh2HmhJRs2Xk+Co7d+kJr9Y8RQqmIrHl+PgWFaj
+MmoJmW5hO4fI0Tk+W4aR/YSjmvqBzLpNwU8vlBwKtEsF3T54fLuGpxQFhysP7PUir3oDGUv+usicBHcj
+8zcm8w02RZj5qe70gRdbRqaaCY4iGszQBGhHEZwNvQHiArtZBVr+PMOGszWF1DM/5U7QSH/RU8wMocSn
+3LIGzeSien5TEO0iHBmx0HWeUPHZlmOp+DW6JizzrXp4Ds7jHRQ8LugKGVmda9DNMeQ5PtFXuIslbFbf
+o+kxcGcFzhnxA/ohRLh1ED9zwg6XIH0u8IupEb+g4NpdIyd5UK5X2PCTJ9VtrOMGatZhI3+9rqMKWfqM
+cL83lNXQpQMOqxOspYTqiwmUTo/e7zSy761zexqc0BnpzM/vWr8/3Px9mPVbzlzNJBzVFsjefM5p/XfL
+B/LXdcErMVE5dajj8KH+F7fx+9obv2e5ZSLjRoWpdKLryzN0/CbPyljU53TObTfU1/Gb5M4m2Jo7uDoj
+YPVWPuZMdOfvTYZyoLgvR1BcVG52gpLCyChHI6/8W0h36DckDUWvifMVKnwPvzMfUU6V4at9J6aYhCLP
+haRaRIZOws7vVU08qhFqCfS4BYnjwezlgBR1cPJqwL5qfRJU4AcSG4zpJJK/zUZmXhWqjiFQ/u0vp+s+
+f3k3yP7okuKpSpETENNtJDO/WObqgU6U4QnxN14qH1X/QrvaFWCylJDCv09uOxLMSjd8yodDucJsWLD7
+bbtjKeTe5Dva7UVytxL0xejL4onHf/+7NA17ygZXdqJcHYviXPavs1M+osgBdZeFuhujvO5E0Vn7ubEM
+Fbtg1xaRO737hVwlnNMUnNd7yPTvXW4/dP+i5DKlbTQZC7PsHyCsbFR0ZmKmQHF90DH5yWT6/P4y3BOf
+x/kJ0dfkohxdQubcEWK1BFnsJzOOCY00Ro6TBXdOFPS0xx9w922KZlH5NMrukuHy7T2I5vf9F+UaX75m
+7iWh3dvHo34P2atcUDHzVnc6IR79A5rvJFSHC46LKJDKM2pu6kA+mpj125aPMX4e3k8M6fs8T5momPsy
+uPMI7fMsWEP/5qwLgpppguvNR4FLBDvzU/O0RKaHxqj4BAo/wtni00+7Apx5+5c5hHLtBK9NvxNVB1FZ
+hnmhhK1jww+XSdMHjoaNJ0Cz2XFgZjlBzw+aaib5m3a+HkJPV70AL9iBFGVC6+9MVZNflvx8aXCD3Ioh
+cik+ZFR8iXjR8H0+aF09AjeWMB1OMMhnRpnMxMltPDbwKPaScGsp2EfFb6PeLtx9IQZfZvgFhWSk9stn
+NcrlH3Q8uUDeJ3F3R6JYv+369T9Gf66WPPKQs5OCnk0B1EqJzCgfSC3fwUknbooGhzeBtHMN6R23tThI
+ZeXC6SnDFXYi57Tx9LdYpNVvMntGZnr7c6Ii7+KM6vrNM7vWBfbk7ws7O8C+HUF3nfGwtBtKeoyK+owt
+1YNxdcB5GwkuJwsu9SayHmcuq8/Ytes39+Li8tSoJG/AfNx/mnlfesQhb8dPSq+Ejnb8vsv+ARr88+F0
+Ympy34xi3gHoBJ2RQkrJ3yg2C86ohsO/IX1fzuU5Pn9jJ0oXaySzJNhf4LOm7lDLL+tBW/HkxVtwvlCg
+KJdQGbNCRtcR3Mw7ML0BV8iPcnMKpZ1tmI8LkoxtiPfrycMvXFuo2t8vXV8Myneedyza+M25Gvt5dMLL
+AUv1A6pvKJQtDTiuLOTsfnpiFVSbNjzO/IJVdShVr96Uar7M3IBmQgVWJxuKnk0kiw+/rUiI4UmYemYH
+prvRpVh42vnlUZ3gdXRjSzkgjxbs/e3a7uS+7qOQV/s0aLETrOh9FGcSitnP0vLF2JN38F+NDc/oExo/
+sXIFd9waGeEcnOYKznlEQ2kJHl4tGHznRtoUqt3fzHRPam02rl2EHVl/Cg+/XN6DcqP4A1z161LqQunL
+b+NOgjXJRmanFnT3EpQWFpfEeY7Iv/D7Uyl+jahERiugiEqC4YuEdXmJjjiDVI2Re1ShaLJg6fqL5ORh
+0nGoUbag3A/OJ8aJY/4eWjTmdhZZEhfOm0pUpY5IZ6S/ogz+fVJHVM/soCKHBK9/21kkyzDf6dP1d+52
+86Cv6IPKkmHznYuuyoLbkYs+n+D4LTz0g3/BnJv7Tbjg5c4zo65hxY3ATEoQY6vAYm5Ad9pFtnL7LZ0J
+izGHpBPybROVEdG/RxsFiZLA20sjK+YCvzc38K5uUTseKPsRr45Rw7dViaIvG5LkguUtfhKJQX1+vL6N
+QGZ1C5KnE/XbDSH39FvBupzE8OktXt4XXhJgyL+HrJiGdtIN18RHSKafqN0FM7aFmlgnKkcLsWYWG/FY
+xmc/j9xFKZmPIX/J492EQ2TgejfhtW+grGwhs9+x8Z4HqXwUp7nMLwcowdxwIsno3zCzSjCsGlh6lD4+
+L+GGLiE+BkVm8qBh6qnY6oDi1oUaxwgqqQ/mbYBNx0LLigXv1xDKR7S5e7ngRrWRvA5CdneAlRsJk5uf
+qe0HSrnAqVihzOAiKXvB7rtC5ifLaFaBYv8aa+cRvbl6gD+u69gsTCreRNmDSvUdunPftk71Y6wUMj2b
+IPfTXj/qe/Fohz6JQ3mGTrdPKPbv+9NvLhWyGznqXLDYNXCx1VAyGCArJqBeV6N89fsksm+hIjOQN6xF
+aTIL3kotOKf4zVS+BDdvFkweA26TT+esAe/zmeR1gMthgmqxf42PRO66A54rf3pLEVbl6yr/rKG7nz8h
+BhfRQkVkNhh1T/nhFZFmmqCTP3g6K3+wetXw+J7iwjfFI3x+090IEt9J+wtcjHvbem4GzNonQlrvT0UG
+KicisE0btZyEZ6zfvD6uvlFrsLZ2kVbpYFLRoJ5+I9+Hq+0MML5El6hfZxUjEvVwCvmMjVA55w9jPtk9
+FmJuGdpdJbRW72/0qUGFFQF9vgtS3s+G4AOVWYKU75Not5DaqfysoAJ5yfxwJmBH1Jt5RUJs3+JSTfCO
+DbaVenP9DZNe5RdtYmhIL9TBCphuDZhPyHGs2vBulsGyOPBofpNkzg5UH24V7cKp15+A2uBmChlJfeGb
+00+iNhyfvT/YEtyTe7C+hNDmJDJ4atibPGQpF9ZmJ0x9QeetFcyaEBwxQTgrg6inakAXl9CmrpHdmQcX
+owZk9PyGflvECCaStVr4vuyA+jRem8ovrntw/k2Ca++AcbsHydZD+MpBOaIJVfsHiPqj4DeJ7a4nVLqg
+ECtx6lmYdUekfG+yFZnII3uhkcpgdmugLedhO4LI5BBs2YJqrIfOH6U7TcEb7YP60g254xOvxYKzp4Sa
+eiqLnLeg+9TgVX85jO0E7Zjfu+1q5EVQoD6UvWIX8stXKbtVqEj2wHbfQOyaRnk/e0LFCD6yEpX11IAK
+nVDdw4F7Pv9P3BGubvkzCPCx1SsU+4nk97iAe9jQ2UqIeqdu2kt8MplVd79Bs+th06NCnJ5Eft7H4tb+
+ZjchIlvOJxQ+NfktrX4wLlrVjSQ1A31STV6OoY+G+DZMaOaZsUw+Ec0ft7lsqLSZcGj8g/5sVIRuIR1J
+zP06n07B4VFj7vTQ1jRK00dkSlGwnQV3bRGy9fb6WIOMk/i08jCsy0FKxYbrRgOx9jf9uqyE9oaNrFAO
+alYDU7o/FmaoI3Lh2hpIKQm6xyJoXb+1jsFv1n2ojC+2OUg4I2ehKGfCOSsjezsSsxMEg8kEb/Luqz5E
+7JdPteE0Mn8vH/SJ3CZ/P9IPwio3dBkOjKahT4GnJ7S4HfHRr06kbkMYMQpS+AHdmwVeZXwxnBGgI4NQ
+p1egKmvhdvzG2r5LlBkTIL1BJGOR0+FfW3JR48+wp7uAPNUNMSdQM+/E/RUZUJ0K7s1Eu7+s3nnHYO+t
+mbSQqvnE+KWhLCuQ/Unz5TPB7nl/cvc3o7hA1qgF5Ww0KrRXYE+d4VIeUYrPbom88dto/RbB2+yEdUGM
+A44HqM2/mKkmZE4OsqXMH3RXj7GMF6anBWf35xUlvyP127hk0+B6NyGHsrDm6CNBi+JmIsUeg9z+jT0z
+MqRLDTz8qHB+h4G9AzKVDd+48veOHlCx9ZtlvGOw98iss6qgdi6+ANGMm004HMrfaJeHvt1B5Tv2pOch
+3PIGOb0M/J7xB11bDxfsQZ4uCuTN1pdKNeDW5+HKaILR1Yb/aldF128gd3GQGxw/Y/s+PT2TUIkaKGf0
+G1ZV+Vm4kBHmgBPxV4zDC952BTofPHGExFUgq64bjts6OKLrfjBWwLvBhMreRleyIK+0qNxdwSFW4Zxr
+eLh98I12YJby4I7qROYmA6JXyJmcBDOf0sOp13HwApZchszqBfPyzW54yNtLgavsL11UAfbuBS639IUv
+POpTLpTqGskjGyWFAqrfZEDVm0WnLTDaU1BeOSHFscCZqYXO8Wid0gFe7yyq8jbgqY4Gx7HE5vk33D4D
+UEs6ZE2zf8NNBLrDC/k0iZokA46qDNzmO6/jHJSdhfTmEtoOBqr6L8qlvzkveHQQcvvX7dGXGP4mHvIX
+OVcJnkShlBFCDlv1CJQOSVMJhbMKubYe8Uq+4+M9eJRauH/dwFJXwrus3yxpJtT5m0NyewOue7/U3N+w
+73SflIvJnIVvLwpVqTGoujikvxBW7tWhwiXBq7tDhpl/+XGfuZILxvIMfUZgtlVG+hkWFVluVInb0J/P
+fcQc+O5EKJhcKOMrWsVc49g8+HxD2PzCq2u/vO/MwPIk+Di/P6N99L/uBnTGNSrrET7fpb+M1oNT0kMp
+JjSzFHhDElo+lLIijKrhCEztxedU6R8IWQlLmwVP3g74F35eRQokFQNHLg8llxp2R37VCg0lNb8/EXm4
+d8w1dCcyNmKhslZgz5e/npoFZ82BdF3G2TbY7v4tSZ8CecU7MMKfV5g/CGq3E6rxZ2VlCfrIWirsg1XH
+Hwy79Zt4TRs6KwIsxtN+rjsx5423XvODy1dG9mf3PakD2cwEKxUCO939Gy1UKFJuKE9E3lwMfJYa5tIL
+d1IF1vh9oq8k3Ww9Kf98yGT9wMpUEe6tJYoZhtZ5i3RVYc/VyMjPdqERUjUtZEevUPlZz+NWB0uhA++U
+86WV6xNahd8/brDlMxj5a6CKeSvv80Ie34FmsZHr+I3Ku8TN1aIyuAN15g28ubFw+vewqBn0ZwtqhpEu
+b0KnWMF14c8J/CUg3OfUnPsNdabh00io5FfrKNP+gR9OMJsUFDznl53f6CQburwTtFd/8dITyLnmb5z7
+C63Iri9uOH49bxS65pClr2pNZqFyde9k3xoV19XgnX2gIxyg1iNkXn6+WUdDOcfEXSiRd3R+lTaM7D0O
+kloaGWkvxHIYWZVMJJ03qPlidG/IAXfbX6pHEEpuD5imDsnb/bXT5CHvkWVzxgL7y2LZv8Kgbn/JiA1G
+BBuKCy4+4xHHDqFUv8aZx1vcjRPU2ODo8rGI8F8cxsIZ/WSU+6IkdpDrKkOz1q/VcuqzKBZcUynoLztG
+kx2ws+NQLerNk2pUlWtgVXcji1uHUq8HZQ4DulEedLn+Tf6+Mm150yiN9Cb1aeXzm2E7KIh1tyAzFaj5
+Sjz5oSv9m4Hijea2DR2/DJP11DtsIoq7e7CnQl+cTmCDI5TnMYorza9ZdSak2dJHNhP6BMaV6h2Dnvp9
+PzScPg8yVY9SdoTg7LPQui1wn4RcKu9fuMCXoPYQfiaRrU7DdYzf97UifKEgNtkLaqMOG85ALlmHPN5v
+rOjHqcr7yFnU07ZYLejU76y1iPRJiWvtIvPmFuw4DfLav1cx8xurmYUb+bfamh6QNYHyF0teHybNHBT7
+50Q3C2JnHvgFsFQIPdMF7afN/XbpowcaPF2tQOUDYehLeKvi17fnHePXLTNuEl8hx98uVUIh75coXF9c
+rwnWX3JHsIT6ASeUHwmmqRW8nx4RW17I+Wahsw6+fpJm+fdHupzv1yRcrTukuy9hJX3QXcbna28hZ1kF
+n9UN8kuIvZgOiO4o8Ppz6AqZJfILHCikrflNjkwiO2cb7F9jOJjxa3BPFNVXq/Ba4Pq3JFm6biQ76kBa
+9lPkOdBGzyKXvtfOI8h5jIc6A6ejPzffV4n0ywnY2ENGfrJrn4lycQ1HbA86KgZq9QijXSwZgZryIy53
+RThnc5GeuvyUyALzkuB1llFabyP7K524enzqnL3Iy5iBONqCW78u0zW/P2RuoDhmITeChY5mwPX4n6cz
+hXToCXZrLxyOvnccM5Eem/Der5PlfO4JwvSOYMd9dfOzC6onrjKlPFim9te271Bdnzks243c7fMPgo7f
+mJdKeKKuoeZ9tQGGkPo2wdJ3xcBzIJC9TSS/ivyK5FeRsvQbR2NoPEdwmd3gmlXQub7a0qRRX2Smw+OF
+zcgDc8KByainCXI+KzfxOBMxt2zs22k1n/bUPdXwto4wV/G58fJQk3qqXR5SsZrP5kgo/et1FDtgfWV7
+7Hg8S7z9/epb5J27MKcmVPvFSs1wwbnLRX6RPlUbYyi6N1G6aSHrAdc1Y6Rq9LmABF2qE8keB7w1Cc4X
+ehmpGzwunFAqOqCRKHBP+WX5NJGiJ6DY+jzpaUK5WQNfheCpN0PH+uDY+ehmlqF2TqIym4SqJhZJdSR8
+EZePn5mQM9vIc7Vx+XRuHhPMvXvYFoGUnw21b2rhHJVxOh/ID3yfDF+O4P7sEntPxO+7POw6D9zPtrFf
+QrLuy9H0/hqz4zdWRrWQsctF+RxfBt/8GjxwnBE5sLRs5GhnYfokSBvDL61qkRHsR9Len4gMUKUs8Cu4
+b2ofvtSjs4weKFZuZHKV4Fc9lsVfhybvUOKzj0SokPkbG85b6TtMDU29DeFN4NfdYMhCKm2DNR1E/bbx
+N9JwH7/j6gvvHNQTTtM/mM2up43Zv65vuVC0xsgwI1FM5UJpXry2+etp9cliufCxfNhrJrRZe+B9+nNd
+ln7z9j6lJ5PIrmzDWxEFi96C5OoDORp8Fear+4t/jL2A5bovtfreyehpZFAZuAzxN9iXtD9VRlZHGZt9
+Bj+Nj8/LJmddIWOffu3WEEz/VS6r2S879AsBA2u+4+fZg0+qP+u9wC/POdcK5NTMwfFnsYubQdJVRE0G
+AxmZc9gYFaok/V5VJsGbVIJfLKz4JQXWVTZU3saYd7Dzkcjqmo8+M+GkiOynAVvTMjLZv9mechc5XUxk
+Z3Dh3BqC9F91e8WCur5A5pdpfp4mvO+T7PAFnOZvhPnyT1QikUW1Ybv3bSsvwZjUgKEdoTjKQ86Xq/+l
+KzNn9ktO39f1TIJUej6eFPBmhVH8/fdo2IFZ+YM2XfuOM/0lZ9ViuBWg+lSQNEuY2eyHMXFQ+LSwp9qQ
+vhIN6kxoVN2QVTfwZDqQ/YRE9bC/ghFFaOmE4wnh2TVXyNGvQ3s0SCHP5LwNElG2Z+Gm6pC1U4HUhQ2v
+9evnnvBaiusEJ1OBzlTBs/trFm96WuZTBSo+p0PEQ1g3yLgm5Og5sHjVsJg3GHcXcvd9+BXRddfTL6vl
+gPhU06XUyNyv7OcZzrG/ylBqTJkHlYjfol+C9uPs8c6+s4NGu9hYf841FgtW6/261K9tLHIqaWhCEuRa
+H3KrEtbMDEhyDhXDIfK3OUbm5cVv9vJh5flKWP/+fKzluFtwxkmvex94H7hicuDMNZG+LkIW8y8B9HNn
+n1FOvd2Pz6VzusT+PlBVV36Wyi8IuhPLuoSoDORszKA4dU+dvDJ0yS+IRs4H8BM9Uhtg9BebPhXErbVQ
+T5JgVlEPQe+3ElGJZLIabH6K+EoNcu5pKnEBX7g+vhcBZcw7kJnxa+ZeIT3HANPzFXa8IErT5jeMofUM
+ZMYNpLktVPVfibDqxlhf+Qnf7/NwDlh5A5rXh3pMCbr6Av1U74jw953iaavUXiFVbiIrmUY9maB7VhB9
+FqTbXGQ93KT1hat1fyF67oLT4UXpOg/cqK+o8zJg3ec46A4kT1r4WleoUGfCYmxBWY+SJs8QxzIyO/+K
+VWbDUzUGa0NgTuZXOuuIzfhO5N5vrMin2MVn33bo1/29M66mPpoxBLkVg5uv/tFXUdEUwagNiF8h7+DA
+wxzB8ygHx/dlz8wN7Iw1HGR8dRL1ufcioVnXI8BhQeP4q500v1HWrIfYNir8DtgPCwim7+96pRjwVh+j
+jTSs+xjj3RFZ613s5B6kTRJ52c53ikJvd/vXui09xJpDej2Gr7oLyix/iqjm8doOOOe71cHaQd56EuIX
+0ZQlIbllQ7t5hvLb5u/WmmcnarjHNLJ+/4Ece+DN7AK3+guk8xnZXhU0vaVPMRE4n7mhtGwwlPcbXTOQ
+ao5wM7LBbxvv8ybPuOGvJlrV9oITx/x12zPIng2j+kv46HzHPMN/HuwUFFQM6JEG6vlMLJVDKCtNVFX3
+wZl5i81Zg+ecfJCSj4F0gLzsQ+bONjLvLlC9sfp82YJjagyZq32/crCKElhflJab+qqnCN6uE3IsHvKq
+vmoomfEDcn9gkWVCcWNDGfx9+Y4HdB23UF6u3vH0G8aC2vEFbZUP2T4aFfqq3qWmf8M1/VXjJ6RzJJg9
+KVTWZ+ulQtAVnWCLmciyOShNHexS/FrrwlD4szlUxWc1e2ztmzglf3YqgqHJRla56i2liJzRLmZW/EIP
+hCz1ZwCdWlCfqHrxlibrmk9L4od+ibTuDM7OZwfOS5htGV6nC7y8DEzWGsw9EvUkUqS5GljzxfhULqSH
+OhVhByp7DhZDiRxyCirF5BMV+1Bj1cK/sQe69Phhb/M32t5+5SYHbJNCktcLnqsLWSUGnOfMXy/s+E0u
+q6Eq1qLKPQvKm0bl9Q68r5eay0eUoiPhuU0+6Vzf4frWshpJtQ9iHAs5ikT5VAS7VYW84B3U4TaOj29P
+/LaWO7uL+9K7tpILTt8X4Tj5zvPFF/2+C8l5/aVOG2ReDFh0LcwuJ3JUcY/jhODc4sE5k4PaLYKR89hz
+zxzoqC/0ocBrJUp/1at8Fai/ald+518X84ObGZ8XrbuwEws6exe+HBbIil3kidcoR9yhuH9lPGqEFLMG
+meUuzJ7eecwmqp4cIcbjDzFHw49ewOE9g3n8al/r05+TRpX9a86jheyhC2mmD5zYgyXyMQYx4YoWGNW/
+V91MQltdREfGd/PIEBnMbORp84ElI8X7u8ImAupS/BarW4uWHjlx6/e5KL2xHo/+jqiWX6UmUfCuvisA
+v3en3zChyEBNRBBqOhtNDkFz+VA8c7HqJ+Flx4AhznclYxjcTRE8PsDj9hbeIOedvU6Q139eliJoiQH9
+pVZPxgiVOgY8a/8abEah0s5fO3cNasn9zae0h84nb3XVfpnPfiirwOVcwawOItmfAS8YDWUGH4ndzXf+
+ekEqtuCIG0He0m8CqhRqegR26RbZuwft5c077RvIpUp42JMWn+6ybCLDUwvV2I3qsgln16+PidmConoC
+Mmv/LlcJ3O4Q8pejXVlzYEfUICNPwuwM4Y2pBoe8A/OJ4tKewPwKJPT1QH5ow+mIwYXzft16BtybzB+I
+UV+5Zxb66Vyk9wI6fsrTngapODbewVzUehPplfrpu0896nMho0PI/u7SulwGxMhYuKu3kOuR4EsHVDtf
+2nuzUPwCNe94yPTuwMeH2dJvwTKLjznsPZWxSoesmxpkV+5hH3NQq6aRsdqD4+TBtPKQHXFEUe34Na6D
+NvwlgOQJGdkl5OYUuLm/zyZu0LV7YEvVUNejYYopQXexnyErkHemwciLL5VvwZ7OweidoFkllPqy+8y/
+JDIHqGA+mToFVkYZPbOFWU6gmORi525Rmo+jlw/lLBtbp4Ymf6NmsMtPKb9AxakFZubyB0WxoOzO+6Kb
+H9bQRAXLRCtUSGt/PcoyrKYW1fzW4u/eA0YP6nmlHNQK2Tf89Xv+lE5z4LmoQv1l6Zymjcx6aKPYFWT9
+YOWk/X7td4XKZycks0B+5lyqjiAf19fSZZCtTNT+QEltzKHz4nMyZUBWVH7yXKJGNQld9yZcrAuIczdP
+QGli2FxIndfQ1kdVfzDgiZd+QD7U2l8zZ9UXDRPP4bw1jyNmJDJK8u/hlJDhisBvJWtRkXmLPLaMbN2X
+MzYt+KoqUJPSfDeqEfy73EDZ9+t6t1HDdiF3ZhLbUuCqZ6Dee+bXp2izShNgeKtA1eM0SVdBCjHh468P
+MpoLVtYO6Mz9EGoJd+yT8eva4GVdfS7VBDX87g6KefLQ9oGh7kJGVD5aWofUzME3r/8MXYHFjS+n042c
+0C72xp9jrL8rKZxgp6pQ/Rno7m73B9YVIY7jYewP1sqmDbp8h8v+Uki+mihfrScyJpA32Y2M3CgkZyth
+bqagqnAja+SAs7cNx6QXnshcFJVgqfZDkwXFOdRmC45NBazLakyeniLqryJa6rdSHWOQX02s5v+ak4b8
+2c+qpxIVZhBTHMN7GQneb6Gxu/lVg+FCWbl81FuHskeEej2L+SKDWdlfeYeDR/sUYWX8+h3ru/3aRi7N
+RJejf1+UDhb5+56ShUyKjaT8edp8hBQ9gWYOkW3dW7uJQf/t+rQSdJhPeKyEZh8j96UaqXVCy/0NOa04
+uMx8C1Yt3NTcO7p7MK8cWFUR+n14SHN+MHlPKG0Z9VHM3RtIFWd4e2aeDpGCxr2JpO+dj8p8doe7Ah8/
+4W4ykft3Pdd1CHuqBeP6t/Eh34CZl6DbQrEnoaeewvGVka26aqR6rlDfxWvWOBrJcBBOP13al9JDgwyU
+ZrPAiFrhLonsri00H303977S7iUkP4/AUgsmOYIVsjBbXb/O952kz/jXxf19IV6C7OLAf5rCn+eft5PI
+jPnw9beh6mIZ1l02nFomrLcq5WJ8ebIFx5kDZcWvdYTDuPlLH9fniuUEdJEzUMwXm9/jz+uygaJnCvxq
+k9ayUrBKiWTbg2slUdGaQ+ovtu5TK+P2B0h9ftSgFJ/bPWE73ejlLfJLFrM2/dS9MpH9yIM2ehfM6Cac
+Vyqov4pWXZWGabagmoeS+txA4Uf5SzTyMvUZaWKhHbtwxxXyf0zV7u/VxfpA6X5j6ySCp4iB0twDOZ2F
+Kn15Q81Po49quPryUN26xX13T0uVBaenDzQ14JzGYGRufw5yOPJTt6YmoNBXbWZ/Y+QJrLpJMEY1KGfw
+UJKHkLQqXPOL+5IGDv0mdhWfa+q4cM8jnpyw4ZxbYay438xLBHf+rgth8ZMpv/t0rr93B2aSCVp3gusv
+Bjx6kOqykH/XW3GjQN3toZI5i9wnTNZs3t8vg5mzCeUfWsotnN7ZlYuHHJMLzuipS/UlMnc6wXpI7U27
+frP/lInlEVIGv8O+i08dT3sa/ZUWVcxXb5o3bz/qgeND8Z1MX3sHKlUdGPtFW1ItdFuB+9JYvNEFR/PX
+ePSVtJntxDIivxDNxp5nwbP3oLrIwz4Dppd0IqnkwbH3v1pnoEKxCe1XU/q33o9CTgnpdyjo2wAzip+p
+bAxHqfeR3oUv35J+N6C4M+bAOnVA5/BfujS01/l7xtBCJPVlCgbuUx6aoUNux6+n/ayq8dkjfyMnytEO
+pMN1v8EUoJ5FgXfTiTwdic28heKJqL85pJFfySI+xKwrGqWwEiLNgdxZg+RnJ/ptpXY2GxXphLr1ZSf6
+sRN/F55Hva6rkBnRAU6t6ssMDjhW0+BsueDjfJd++QgF6y1eXBb43zgtP+KRTH2F+BJS6fXZ372j2xrk
+NkeQuh6n5hSfgmXD+WydFd4ktP2DJf9C8LZ/j6r+co03UX3V9ftuZsGKUKJSDCI3vyJAyzIUOQFq39Zm
+UgHXMA75W5tFVVwvrJb/iOBh2r/vq/yVDhJR8v0GY/wvApYgH813524iv+rMVbwvSXofVFWdUFWNfxBb
+A1dlGW6ejXTuDT73qdTTBLtz9VD/1zqWn4eV/E3bpS+x5OoH5F6C4YoDJ7WJrDUNs5kDZ0YdPDHfXTrn
+wN6vmcfdQo2+cuZWoiaTRNZkIzcooX0VONkHBUl+TN/IYPmQmvtu0Is7aLLr98mVB1XNIPxr/V0w+HvI
+PP7A71ChO/XWzDXg+Ksrf9Qi9Vd8g9P4bdYUPPnUjpFJMG6+aOb5rLz9uLgjA8fv6hO2E2VHGPllJ4kd
+BbGlBSMjBP4gJZifh4mZIvJaJajzcvHVAvLub7psfq71+XUfScL64qPrPmbW+/So617QddOfhS/gnrTh
+H24vSnE2GNooOKL7izNsiL2ezzqTcEXWgOp7bL48ifFaXw6ZQUYFf98/GunaEZghEyouG7T3c67sw6xw
+C6leFqQMFHMTlW19T86QyAuUKpSQd6Nxd7Hg581nHLO/ah6DvHfWud4wRHIKaW8W2PvVzG9fwr0Z+tJk
+P2V3BPrY94MxM8BhfyWgnESnLZRde8i18pDREQE7nIv0cRc12v1yLHVQfqZ9HzXIyOpF1sahpO9iu78L
+z+v3rK670PFVfIqvWrUZDc0PxHLvfSl+VTAV301yK2TYLOz4fnMoHWGJSUjtJ29q7i9erlHlcEJUzOCm
+z5+LMcD47j2LZApOnY2d6vn9iA6odyuRX2Kj4ovd3lYhoyIHXnkS9uqIDN8USnPRsJS6R6vq8c9IgzHe
+w3y0g9UiyKAXs15CVzWNvMzHXNou5Mh5YC0XbPnzRecmMjsS3aUEuf0buHu/S+FGRFXnd4fN7H2lG399
+2EyotRHIutQij6OCtlJE/oYW8lYbcH6pXvk/HTzzMZ0TxNXlr5O3C6lwgkx2QufPwbZKIUu7RCbzt+X5
+mwbny0ufx5Gclx/qXfJhWQiuzkvw/NWx7ppArcoQ7UVL8bnHjbI/1ZyL1Cq/3DI1pPnyFhwirD4virr+
+vet4ffi3L8obBSqD0fB8lwBFhAyqi0LxcyeMKgPuz6tgTQgV1mMeH53O6D7Yf8J+qRum7w4M72/wGhZM
+Vs975oFDGQXl9ufc7UqwvnBI5dQPsOs6VJ9GYAe735ydSElfdfRUgXZSv18Vhti1hLOijdKXcll+JJFN
+aDT6ZKfH52NO4Dk3kOeTkVk/YKu2fPhyH1OaaCRbQyiTHUjP3sIal+CofNh0WY84JJ+0Lh6kov3WMgKV
+z+/Hi2DBuWIjxdWjBb0GZ39/vssJ5Sw1sivmS4TRI4fxuY54Afo6Ci5eIb+L4p60E2DysVYqJJRVJVhl
+zeeqTHA0wibbsJlToDqexHSOw9a+1SyBe6n5KqcZ6bkccKf+rnjbD6mVyL9a6+2S4JHZUHFuMF9W0Rc0
+UvVFOyf7kyGfXtRPm855emHNccCv/DSlyYEvrf39+kEYQaPGOkKzmQfqQ6K5ehLn8OLLRVnYOSsUH+v1
+TOJSje1awSf+3fZB0KwbFFVPFlQVLpJwdq5wnfOEGx4yLtjQOPlX2yahW46+0v356zVUqGcMLn6CZri4
+YPbwYWVbyB+gAYeH/sSDQc61A4o1F+nRdyvdRMLNNeFhe+FMzUF+tH1UMUjObWDUItiOGtyXgZiuH1Qz
+7oTafY0cjYXu0MDH2gRv5nOT/ubb1ZE4moty3cePOhs17UrkKuY3VlQ1qKDuN37AmR/FnMqFuTzD4Ydp
+86XDXHx16L+7iyQwSv3FV9wPpC/q9Yc/qO8qK28WBXIqjWJ2PG53mSDVnZDkDFRf1CKvbIEnljFVx3d0
+SHByn0Qw7yxUPbnlbvQgUCBVOV995/iykI5f5VGCHwlQJpWPuWbBquJ9evhvVp/2lN1MZNUkkrORsDo/
+c2xdQuMyzMgYeIJhaPK+DNrP4z91Xy5ZN1STv7lFVv76X5/gsa/Rkh+xK321z35zm1QG1LcszIUS9Xs4
+2Py7xGf2K/J8gfzzieY5EklpC4qJ5O/X/a/JCha/zOKLtz67Qk7VVyj1iVu1ojGRZ/Cv8mWOGNCGSNQy
+H0d49CuSKpSnP/vA1sKf67V6W4a0fupaZiaqNPPVGN1Euvqh4040mNJvBYdvMyY3C96ZMSzGM0ekYpFR
+S8JTqf692x2oektI+6sENqEDVTuBDd/TnOlD/mb/OtHcb7gvWqnG35V+NLJa78vLeCQlA7nT33bfHfz5
+rz0hGx3TAX8+t5ytKWxyAnSSwkzvgVvhxvgNlV8WW+RfpN/DPff9YPMXi55ifqWQIuDJaEJ5uwlGvUPh
+zk4wfwcB0y7Cu7mDO7JAJV1Qjn5f60zw1yv3z4rfsYdlH5EbXYNMloSO24N6f6NX7adWWHxm7voBdu1F
+1tw2yr/f6LAF98T+5i2Ua1PIcSWhiZ55pPmzjYgNh8wFOeGGTY8gk3okJLffdjGht9I19/vD0V39wCAD
+69+/yYheMO5+HWunvhtNm+CFFvUXCBm/NwxVI1P9lHHHfZUcwpj0LlJFff4v9a+j/oF9vnmda6H1LuHM
+0P0m/iUVxBTYbi2oURu5e3PIDuZ8taN/YNS0ILFyQLOWj4rsIKNzD8x49L80IsqR3SD3up4GGIa47oK+
+sp28TKLEpw8o+GfG+XU5lXDXdmNif238WxDQ9r5DOPnA+VKH5K/OYJSfirsCRecXR/ebTYZ/Xd4aNXtn
+FK/vcZkJg2HHwBH53RbFaOSuHx/xbyvMDQm06sm/fwUYHRlf4sqAWdLAJO/37roFhU6GqmceGmwnvLm5
+sDu/a4YcRoo/WNzqFbKzJSi7LpDS6MD+HTOkqg3x4WV97o978qv6rySUehu6qFrwczRnrkiYpSUqPz6k
+2grIyg6sJgMa7X5RnZWgFDlwifVrxtzGX35thEqofOQh72r4yC4TDn8xV3dxcFR2wvJvblyWEprPe3sx
+698GbhDkTQlKVhjlTh2ssQxqn6j/8AQVkVfwSP1VZlMi9/cS7rzzpxv8Rg8qYZ156E/py8oD9cSCiq6r
+L/n0wPvuufdffbLhgL7f95I+o0CyCX/xqJXsTlQMe6DtksEf+gb6f3WrqcDGY0h7UEkK5BMNlRNM5O0S
++dvhehtSRuaXZsbeqM+6+OW+88m7fopPROoLVhMqxM+nu90PMS9RSuWgJBuc+ZTL/Q3nmktD96HglPeQ
+fpS2aTaYvvuttnMNZ7C+XIUt0G6/c1m/mRdbg5wvoTt3jN8gBTU5i+xtCtTkPAH4OsDqHTgcz2Uhm8jf
+aV7wnrRPNU3Ix0vYiklQN13gt+g5y02kuF9uzhdpp8yATXMw+V1gUN6GcvaLOREDdNY7gJrvCnySuO1J
+VLZ4KEXFW4THlptXBoPHd2DjBInxhqYWZn8O+qo5JPO3/bonS7urSyi3C5XTQdzUm/2b71CFu/31I6YH
+Gz1EqvVEx9w1RHUUUqkz7GXsFxSOvjzIqfs1Wt9hmAenYxo1pf0iUXPxe0Q+dhGHShWJ6vwu4Pem4d8W
+LXL0iJd73tniRYLN6IAqI/KJg2OInVrMcATnV1Ho9u+WxL6Ac28MciYSVeoRNKUvBZIxMDPYSE1xoc8e
+Xk49YjwzkPe+2snNRH7+yqZsaPTJijE3oCqKqPJ1gfubCLQ66nFXC6yOz266uaBjp1BmbaHknEDF9CZs
+1Qr5G/y+eqz3G+EyP9TOr2jw/PbTedD6N1592mrl4y4qtwPefcrK6ClTKYdQleWB/y7i0DoCmc5buDot
+UL5s5EUmIfJRf2WlQHZNIp3Fh/5suMx3UpM94Jv7t9mBtO8L2v1cBjXd8OR3qVl3/MCUF+zKadR3FSc1
+s/v02Dtw3Bkg07nI60e3lklkj64h13aiWAk9ETulekD1VxTK6UVmuw7J+/ieMuBTD3z0CbJXBcdWJHJW
+FfjiPD5nmyYmoZ6Jg2faBZdlglvTi9zZhxYpFpQ3NXCyl88hUvrStgxPjhNadjy6lApIUjfyeDlIa/5i
+amtwGSok48HzrbNv2ge2Sws5+z6f4xiKdRvFuhZ+S1QLj+O7F/e+3JseISvYB7EZhirbC+3WEX/35jk5
+8Ohx5fi95vq7JnQ74Dvnl8MVh3KoiZyJffzdT494A19lGrRP+xXBKdD51pWqMjKXS/Ar1qYv04JR48RU
+LuF0t5Bzfj6rdA6GcY8SphoSp6GZlpB9nx/Rkfy90gisVBt7ukZvkuBpP4u/ONCHYuX4Ss+UDtWdTFTH
+5UKV5c9TOP25R4RURH/Jv/KDNA2rvxjIB5X08GaG9Q5t/Iah9VUk5MDdcQ2VaIP+K8vaW7A494Pn3AS/
+m9XlyEVNLAOevElkTgWRp+9q74x89Nk1SMUc0cGDM7KI7o1A9Q93wNyogh1pIdfh/cSHhHYfd2Q4sf3V
+Yxnmw6Kq++zNhM5Tz312QZQdFmyH4jdLdUNKBf5uFqW6BmJSCZlyg98t7tWxc8jjnOGaHpjaWZBbf0Nf
+I+UZI7frI1kyKq7mC+Gawfw2Eq4IGVmivh375O7fnFd/Tpxcw72PLo9qA6ZtsNzfCd/5AuVkmO8gle8C
+dJeN1bIeh3MjmftFlw0HYxszb2dUHsPDSEGabyvb+vzjcZDvN60lD/mn0N7kX7YridxPFD4/hZDB+4Fi
+9XfDrMBQfhV0P85iPxS50mccN0qP42Wy0tjyEsqR87eEyl+ju8ROViOVGv4mF49GZPfBs2v+tqtbTxsb
+Q9+FKnmr71qijfjKD4Pry4OmNwObmb9W04pfY5VAXuxCtj/f8c3A+RH6nc5Dakyi7bsnICLbTUJKRkJ/
+YbZmV8GdvIB2O5FU1utWv2faSiIlReA+GfS7cNFbfhLaEnSMBSn7i6zqB2BsC6J/U93y09IsUDXf3a+/
+vj2Rsyjp/WHqBG/aizInvtDabJQ1OWgdC8XL46cIf8bzdww29FlHv3zqukJFxw/wUGbDeixJV7WQvuje
+7qc4s/XdlVcREPvJ3Jfc3yfpT47y8IvFFxQfw+j0VyFi67vTOBtidnzXAm6DK2mg3ExiL+a7jYGBcVaA
+rL+bgeQC6w+rDqnreXy1+CmHAW1qG979rY5y2QZpBVJzN9AqWpDniRPTv9aSzcS2PiNQGmx/AbF5X0Jq
+Z6CkoeFoh5Ed/fkb1oZaQUj5WPjT7lHZ85nZNciNy0TOVfXnH/KT5x9ni08YzbV+o2U3NOFKVN087nT6
+YuwvDtLMEmzPF+scvz++rkWyYxeM8RQmaeH3WQSK7Eo4+6GBmk08++IP2NQIzO9uk4lWgrO6A9X9HWBv
+oBzTDf6QKL9CJ4uU/t+I9BtHmn5nWw3uWIeiqfpMpA1H53734GaAG/fO+PKE/LSNMxWwco9Q6dcsd5/o
+990WyuDVfWkT4MQnari2IUb6HjcPwctsw/nr7ZMYC/qEtfL+Fp/t1eeWFEh5FvnbyHoc/PVRj6Wlu5DR
+1EE2H/1sfSVGnt7OKGMZ1dhPCPKa907v7zPe57UfBkrnTKQzXHDqavFb6BU844Q7Jw07nukwW2pkWyRa
+YiA/X78sJaHz1oI8Z+HTEWsmbJQUrDeVJ2sFY5DnRpGOgbQzfMo5CZnvDE6zF5uVAdVlLNSpR4juu/ym
+VoOse4soMgppTgqKtL8c1yTs/qwFXQX2sA51LoFd5/n1+N7vUNjNFvJq3sx/M3ti1S3cIz2x/3HsVDfR
+chv+7mXPje5EiT+4k/nZiurz6mwVstjrp/pbqInIgtWVQvV+OPx4cLVNgvQXHr36gufjfrP4C1clv9vs
+H6dptb/LOUaQIlRojgLpeAK+Mr7gI3GQEZ3QVq1hZp4xGzJyvoSO25FQVXWD3K+IInPm4J79De2tMJQe
+D2pDvzlmVhe83TPgxSaR55iEnM55ZH4X0ocjvPDBxaqEL2p/vdhhMOP2ofYcyhvxm/6ntvTOl+NjCU5u
+JDKObHC5UdCm7jeFLxsys3JR4eqD/dXH5EQVSjILtfMbIPeq4KlyICfPiZrZ+64YINwxI2SWeqDM8cBV
+M4PRkp9xb1FZfAsUUyhu/ePpStKc2UHYXqcBSUz3v9j7Uu73r9KdVHnEmFG88nG/dnnMPvTxBN180+Xx
+N3neXb/Yge8sByFHjXDJjyNWB8GJi4U2j4XykQHv/h70pPJ53JE5xQLn4te6rhO5e9HQ+bdND4WzGL8f
+Tl1IzhMvJvngrwOaEHK587wam1D0fIStuHe9xF8dl4edPYHLaEHe+q7v/M3m8w1/XR6JVPoG7tGrnhgN
+VlcU+PulkLI/yfj3xG1mfRKPoc78LoCUhGo/X+NNDkjWJ87FCqWlXtCBFppkBYpbs6DDuVBQG2Dn1KJU
+++qm6reRszuLinMl3JeHatYRvrsK3F+1p0038kolqEosKP1C+oczYOgvxusyIYYO6apnllcgVc4E+SmQ
+Zk5917mM3Oe/juERNzfGb6PuYC+r4crLQuZlCmlVCD6nE/XMwdH1xsYG63na+qMketiG+tck8vjn+f+L
+XfwNp6f6wLA6kJkXA66+6/Ze5v+joqID0vljXJGRKF2PkOenHuTvJz5rc2Rd4b697+U2Uh+tq7qbyKWd
+4Hp8yJiMhe8BAvXkooo2cucuwM8Ni/QME2YNB+r4TUH5Gcem5+U0eQ/09CvWvG3Y/SqCpgt9qYVd4U99
+eKXEym2UWhY+ZihylZA8rxrLEwB2FODWPRO5SmDk7iC7swuqg/vb/sr7/VcVtuHgZEL9hysSjZJvBUVz
+E1mhHGTdLGojePD9dhLsSc6DJA1oHVnfeagDqYep4d98N3thvxy7ERsMVhNUsBdqThJZI2TMbzDuj5w1
+ShH20ER57IDVKTAiLbBjV2DFRaLdJ/x62gDjCa55p2eCm4Q2JaJy8wYvUosdrIHl2vjNlfH813opX074
+rm7QkZ/P2BuGLJZRkc+DFxNG0aXG9rnh6nCjeXr4nELaioKn6p6v+JNk/FGB2gvNGQrlDFIOLFcFTZrz
+UnsG9q8HMK96MBVXoNlZ8DG+VznTyLsnoV6Tz1liuKr8YtdGSG1uQdqwv824+aTX38iilo3+9G+FV0Y2
+3zo5LlB5W4Lyer9exX0o3ij+wcSV57eeqYSzmAt541XGyzo4+JcCmAn293hlH5FRFqGUHMilvNDK+cnz
+53kRgwLZjxBq1mD6oR+QTXDFTtg3seDdTUBHXYKuu4EUzoCsmu+UlAmNJ2CmDZnFhH+zHWScCra2A5Vs
+L9Idd7j9GBt14ifTdIL9qjPsp58z9xNV+9mJMiLhTYdBn6rRpgM+yg9OWv17ItpQyVHIbM8hxaCR2vkY
+nbvrI+R8kYcT329ryKFeKBmbr8hYISfPBJMiPNoKnJ5/s2qMyqhvx7/NGMclGDEBzotxPu4fqLHh9YPV
+nnSA5v/2wE20qvVdfEkoW/d72S8zYh5Y0D3k1fWvDXrngVuC7wb2X4UGRef9Ous0pInMF6z8wpNRxfKn
+4iRejEj1bgu5Jgc50TxYWz2oqBRRzo+MXoSNPsECuTT1LVo9eabh8CTEHNbvq9+aaTIPV9WH3FETukk9
+q9IQ3kklcnx70IRYIFVl/IYhIUPeQmlqjBYdn4TSjW33wJFxv6b6eqHYd1lFPDgXt5HKZ9yIjzhYVQ0y
+sgMlXuv3WxSRMb//eFontFvyx68E9Z4PulwWKmNQP0oqUFk+sCJjkcWTwGhLUO3mwPInWq0u/HvklZO8
+mwL9LXsGlUi5C/X7e1F53/msOn+01IR2+NGhn34Rc6AjP16SlYT+gtb5Ofii+hOYyn3g5Aw/YbETGuov
+4unTDbnxe++75jRhJKtVYE4xwVcbmrG9C6nEQjqsegG1gbSWhBhbg/JRgem1YZp/iBLToPUxok8ZycqJ
+5zKHZkYLx1Yv/Juy4fDqkFplI2sYH8G5E0meDLbkBlMc3F0KPb/Vla6nv227hLoU9Z2OKlBafdMsGu7t
+g1gzUHhsZDinkT2qZ2h4IuDtgTmkkPddZi47jfxUXtreQflV9bv5s7H2Jjw9O3DVw9L48xx9l0HGv7jP
+PvCsT1Z5MOOrRQVvDsue/RSij4CrKCjzKkDNULj9bnAqKXB0CtTGtymWDNG/LcrbsH5tiP0AQF9qX6Lm
++cjUmb+RrQ6cGh9c3Vef8LXQfCq7u6lDrvfXlya5j+UKtGVD45pGX/rX7osQqIo4VH4UQ8bmp7nf4s7Z
+GF//pq/k77H67Q5T6oQUN0Z517++fj+iQpxFKsKCgzUBMeoZ2HYNFpsHtf+cA/59OUkFMqqKSCstcB8c
+8913AJ1NbLQb2tQJSTkLmescKE6EWU5Cu6lAVdKCe6WB13v6WIWRY13ArdlE0u3BQxdU57V/Tc2ns1Xm
+x6p+z1Oxm1jXEbl3NyBTMb/XPISoNiYvF8pWF3zULCizEn6OzNyYwH6LUs3wZymuIrrV8Z2tJtjsKBTv
+dJCL/KhzS5CtGzx7/3fFu24p5HwGNbKTC9bdCqo/+fXShtZ3BUaxhHzlzuy0CHs3A/vrDe76dj/6onHR
+2S8j7eB4AU0173gzLmDdwyqJO/xWMuBZ/77a64/CJypQczuE5/cH2pUBe0aCFJ+QxvWitJsHUdMP9SQS
+OTddYP1lLp6msVp9Nss4okgXodPD6v3IlDM7+E7pwSNzsIqu33fxW+bp0ivg+zJkNYFSqw/H9kKiO+Hf
+aA4cbwS0u27o+fzy5BiUc2/xa8oBd7HBtgjpDxui7vLr+qEZSijzSChlBvLCLZQyIuDyp3a2vaA27zfE
+7iJckx6ozWoMW4QV2oCyfs+rpF+Lm7W4LM6vvRfP1bcDbf8mq4028qaFdn5ti9+e852Nj++R1f358ZSw
+9ptW5u/VP8SEvbyBpJdqJbdhz5rQZmfh7jqR1clGhlKGHpRadrUW7JxoaFaZKPmlSupjOZFtQvq2SvOy
+HfY6A+UxEznuIVKShbzUy8qdShTjD4SIaWRP935MsuGOa74dG/D4NKsp/pUrzqcSgqPJ31frQlbNi26Y
+LLhV+5tAv4qhnUQqXU/iG8OmHzBPfEdVrEbyzI/LPGu3e5Dn57Wa2oKOracbxKLomXr6JCwdjQpuEVWP
+a9epDd7IwrwlYJUGnEwv5PI9SOATqPlIjp/Z57HPFFWwTTXKGQ/YKXbh3mjhvLW/di8FXnUazHiZv/EJ
+WlE1AZOaQv1BhMZOEF43B9UVStwLSd2QCzVX38Flj2D1/tra2TGyKwiOLwfc3DjYvF5wjy3I89un7DgJ
+65LelhySzDvomhFQ/GHalBNZl9izCO08M01rhSy+QsGVhYopIrsvhQy2jYzI+/rjLOSr+YZUauQcBWVU
+G81gvGgKo2alQLJ5h+IECyufQbZfeoK/HSjJvzZ0+SkJ1chXh/HZM3Nz8mOjXVBZn8o73r9SFodczyRS
+3o/Up6deRpew1/dramIDE72FtF+q2H3rvzW1HyOq3355K5H7jIOx8wk17OwXT2MoKjpA69y4yzkodJPI
+icqD9/KTVj8lIYIuaJ3xCUEdg9yeT0ppOUHny3uVXyz7ZqIilgW3++X5SZC1DDBlHrRjGZxTPFKOATNm
+BhqG9ZvH9qKG5GFfsGl8V1oFl+B8C5N3+YeXkkT1fAq8q+s3m++yZK9aoPxdYEVQnz+hdxLyPgS4TEHp
+TqE1PGTatWCWznCHv8UL7UD3KR+pen7dHC6cNRPg9sUitVmJdHMI2XGGXIwDnS+jitOLTIUG5aoVmMcV
+0rUjcOu3u94XoFPDSeSNvxPCnoTv1gXJu8ZcrFHxcHizOhfV6UuozO+M3mqR9xFFyvMx93MFvJw10v44
+N2cMvtph9ifusT4VyS+9rI5ZUC5pjOwCM7s+nvVJG5HbhJXBhnenCjxyFo5PFuXwReOxe+EJ3iK7zY8F
+96/NXQaykxw4p+85WmfAFT8Sv9nvMAaRZeeBit+cxOUe/gA2wv3wh1+N5382jK/n+NQ+Hn9rdvRfwbzG
+DJe4Hr1CWQyYuQTrd9KQ0Xx4bceDGHMNl+QENcoF6xnHNOyC7v7Ayb6DXWQRyo9HbXo/hnp/iHEDVYYM
+XUclKsiph+awz4OOlKRfz999z/w1JN+cccc8MCKbUJ8n4J1aoTkhsLKfVkl/WmX+npjJJLi6Fsbb8Slp
+NQ9Z4zf4+sjKU/NKP3yMJM9Ixo6f8Weg/YtlGSE/RohK3n5nbGxojhlgZMxfTYH6OPEWqDEDs7t4dJZV
+ykGRjicmO5AZXiJHjvh1Jj9DTxie+tTP7vMiPX9lJFj362WO4MsfqBcl4HHlQSeuQV/3YsenV789kRGK
+Rjncv4+uajDZ343IZzRTBrRVKozCUMhx0HZmv6Iqg0pXBfi4fkkvwm+y+A0uBJfuu90YhdzIMlhpQdoN
+QorMADu1B1mvygWHDW5pXwlLGWYqG567JLby+LFAwzsv5KxzBMZ4oY7fwtW8dHlL/S3/sxToUN2nhJIP
+87/PBdsbBmtyP7vuKwI42YHJpFCt9Udw9VAR1/md9RYyhmyko7+LMn9fWpM5yPmcemJOLpr6OMXz2+1J
+YP4h4pYV4P3++H2EXxp0Nry1O8+g8hSf0zc61VMRwZzLg0U7obSukfmQa0+9BCPnpVXlCZmtWqyX9ZI9
+E86bMXLGvRhGEWnWFj59beF2RKOqYwUnU43sUyds3nfvfQZI34mY35MYdzVOSkOn1UKryIWGlmAlN3/N
+flJjpZCxFUJFeBb5rQBSOymoN5913A2FZvYlJ/0+eqLBKfPgY7B+A8xM+KXzqvYSE6zHzrnILv4e7/AZ
+7FMmFNO1uOX+ujyeoRer4XT9BuoHP8OPWpO3VbjzzDe/EbRzv+HcX4n2734sH/nrpoOJem6CWsPMCz5z
+fIEz7/5UpODi76NK1YnUhgOMZaDWK5jfCaq+iD/ID33D0D0ZJqFdFlz6ZrTuX0ucCYLa9CDDe/qc9my0
+bww6un6v3a/FdHXXb4wZ6IpYlEv7Vzc94LZ+j0k8mJdjFM8D8a+SjORE1qi/0WQTmXkZkC7yQF1NQU3m
+YaOLoEc5cAe3oa6hkf1dZRo+F770kkJy8xH6b3U+9eFToSCvd+HsDMO9M4vclfAt2ICyulEZMR/fpSBt
+3bcTc6+y23cUJrVwuHPBzluiXPM80etGxtJGNasGuVE0yNEE5NxqpHL867z6Er7ZWfTnM7eiHbDTI8jT
+bTCtvt9/8euVRS3MnCaUEzQ6N5DDeNw7I0H+Zp0nn5F3csNxzYGyVAG23PoELBJF8Qi1Loia74RZ86eX
+bxKVE0uk66PzufgkvumXadoJTmgbFU9A714KZe0N1PTvbcV1o7tF5OY1wXGnkKr5aOvmGUamG+qeNFTi
+FZS7vydLigFTfQJP+jbu7P7N9Tif8dSClMMB9YhguBIU2y6YJgvk/vrLDKvgK82Af2Lk2JffoJlIR0TD
+vYvMohPTvwl0dz1RZEH6LmE5d8Bh+Pd0vhNPElm/dlAxVsOW715F18FvdY4o+W323CBb0iF7ewVmPLj9
+jl+Tmiu/sxdg87vE0nMF/kF2qFeJ5M7lwwOOTxE0FNED5XgOmu36vXXXiyIniMwkUVnsRr5MD/K7Z9Pf
+5XwPJYQxHsjnDWTVq4e1o0RRG79pbNmvKKe/bvZbJmfASv62Pvau0ZpPmyYLleoXij3+utZfggj9e1xB
+ODdfSZ0qwrqLt/A3cGV8ilArDJkvtLNeKmd0P6PEQWx6kf1xb8268SnXQj+F7o4+XFjE+Nlh3B0Qq57R
+7l71EWfC/AMO89DwlHWg+4HvjbWo1ihh55ZR5KueUZeFrW85s7KJ3xQ64Zh6LP3heJ9LT3F96vsl1J8K
+Ykc8TbpnkCRjkctPtbdKiaz8Pdn6KLemNn4D0ibE3zYgvY/A2IHO46sfH4MN9auJuwc+PDeOVw8Rkvlt
+STQ8yRHG8aIMtz6xYmqx9wL6kjkQzRA898zGsr65/OZZfMdqtuaQKhZ4WUsocjVQdg96kw82aJDprEXS
+bEJqNkrnQ+5nEcsjPWDs2mDyYzW5PYmaivzoSLqnRAjO+wu6jVmYMysomHXQJ20hL3oDj9i533CVHAay
+5hNyVLWoydULEppGktkJnzYLHv6+9Moi0pcUKpv7Edv30XHgg9LYik3YKQqu7DmYUQXNzhW2/c2h/ULO
+7hrSEz820qhyUVh5Gh1+CqMM0zpCsdkBqSiBvo2DfHGfVBUXyB350DlLqPz7ZbtelnvGb8LrXKz9xI3f
+d8nOj4Nnj19w1MEXEwvPp/kw+i/xhFHPkW64zN/MXy1t1bIMZnkJ99ZD9N9A/g7twS/wYmvaOHclWjfC
+pvLbpSNYL0Bi6gZiVs0zgSDl6AbdEwnlzAtUf0UXchq8moEyYwpSngZ5oSvUBkH/Xwg9vh19kCV3yYGm
+fQk9f4oi9EktdSHoE8igmcvE+FoQT2qwPu2WNlgMBvhXKGYyKsBXTTr3ZvjR4wWyI75DNc9D3a9kyGQj
+i0z/XvuUxMoqeG75ERM/Cqv9JvFOtVzTcGWGoexv0/RJsL9JkB8bLUIX8aIGyACDHpwjYJ39zfHT8VUJ
+s9wEkwzhqbK3iRrTCYlxL7GwhTz+GvDtSOB0VqIq2p+RNfSwy+Aw/R2Xj51O+y++PT/ptht5yV+781mJ
+fX+VqurBN7ZtzB0D1dmbeKjFdzsG86xBxW1+amX3Z30WG+aODjp3JtiKFTT3TEmnS+RsZSP13XAzut/Y
+IjV4JVdVqoPyOzG2s4j6PXA4jg97uft7hxKcZiRsMQxmxg3+GwCGOiOZUNYAAA==
+`,
+	},
+
 	"/vision/support/synset.txt": {
 		local:   "vision/support/synset.txt",
 		size:    31675,
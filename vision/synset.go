@@ -1,15 +1,44 @@
 package vision
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/rai-project/dldataset"
+)
 
 var (
-	synset = map[int]string{}
+	synset        = map[int]string{}
+	synsetClasses *dldataset.ClassMap
 )
 
 func init() {
 
 	lines := strings.Split(_escFSMustString(false, "/vision/support/synset.txt"), "\n")
+	classes := []dldataset.Class{}
 	for ii, line := range lines {
 		synset[ii] = line
+		// each line is of the form "n01440764 tench, Tinca tinca". The whole line is the
+		// name of the class, since the text after the synset id is not unique (crane is
+		// both a bird and a machine) and the whole line is what the labels of the records are
+		fields := strings.SplitN(line, " ", 2)
+		if len(fields) != 2 {
+			continue
+		}
+		classes = append(classes, dldataset.Class{
+			Index: ii,
+			Name:  line,
+			ID:    fields[0],
+		})
+	}
+	synsetClasses = dldataset.NewClassMap(classes)
+}
+
+// synsetLabel returns the synset line of the class with the given synset id, or the id
+// itself if it is not a known synset
+func synsetLabel(id string) string {
+	index, err := synsetClasses.LabelIndex(id)
+	if err != nil {
+		return id
 	}
+	return synset[index]
 }
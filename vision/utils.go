@@ -2,9 +2,22 @@ package vision
 
 import (
 	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"time"
+
+	context "context"
 
 	"github.com/pkg/errors"
+	"github.com/rai-project/dldataset"
+	"github.com/rai-project/dldataset/reader"
+	"github.com/rai-project/dldataset/vision/support/object_detection"
+	"github.com/rai-project/downloadmanager"
 	"github.com/rai-project/image"
 	"github.com/rai-project/image/types"
 )
@@ -16,15 +29,311 @@ func urlJoin(base string, n string) string {
 	return strings.Join([]string{base, n}, "/")
 }
 
+// checkObjectCount checks that every per-object feature of a record, keyed by its name,
+// has one entry per bounding box
+func checkObjectCount(fileName string, numObjects int, lengths map[string]int) error {
+	keys := make([]string, 0, len(lengths))
+	for key := range lengths {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if lengths[key] != numObjects {
+			return errors.Wrapf(dldataset.ErrCorruptRecord, "the record %v has %v %v entries for %v bounding boxes", fileName, lengths[key], key, numObjects)
+		}
+	}
+	return nil
+}
+
+// readerOptions returns the record reader options set in the dldataset config
+func readerOptions() []reader.Option {
+	return []reader.Option{
+		reader.PrefetchWorkers(dldataset.Config.PrefetchWorkers),
+		reader.PrefetchBufferSize(dldataset.Config.PrefetchBufferSize),
+		reader.PrefetchOrdered(dldataset.Config.PrefetchOrdered),
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// downloadFile downloads a file of the dataset to the target path. The file name is the
+// path of the file within the working directory. If a mirror is configured then the file
+// is fetched from the mirror, and a local mirror is copied. In offline mode the network
+// is never used. The file is downloaded to a temporary directory next to the target path,
+// checked against the checksum and renamed, so that other processes never see a partial
+// file. A target file that already matches a known checksum is not downloaded again.
+func downloadFile(ctx context.Context, canonicalName, fileName, url, targetPath string, checksum dldataset.Checksum, opts ...downloadmanager.Option) (string, bool, error) {
+	if override, ok := dldataset.Config.Dataset(canonicalName).Checksum(fileName); ok {
+		checksum = override
+	}
+	if !checksum.IsZero() && dldataset.VerifyFile(targetPath, checksum) == nil {
+		return targetPath, false, nil
+	}
+	url = dldataset.MirrorURL(canonicalName, fileName, url)
+	localPath, isLocal := dldataset.LocalPath(url)
+	if !isLocal && dldataset.Config.Offline {
+		return "", false, errors.Wrapf(dldataset.ErrOffline, "cannot download %v in offline mode", url)
+	}
+
+	targetDir := filepath.Dir(targetPath)
+	if err := os.MkdirAll(targetDir, os.ModePerm); err != nil {
+		return "", false, errors.Wrapf(err, "cannot create the directory of %v", targetPath)
+	}
+	tempDir, err := ioutil.TempDir(targetDir, ".download-")
+	if err != nil {
+		return "", false, errors.Wrapf(err, "cannot create a temporary directory for %v", targetPath)
+	}
+	defer os.RemoveAll(tempDir)
+
+	log.WithField("dataset", canonicalName).
+		WithField("file", fileName).
+		WithField("url", url).
+		Debug("downloading file")
+	progressFunc := dldataset.ProgressFromContext(ctx)
+	tempPath := filepath.Join(tempDir, filepath.Base(targetPath))
+	var tracker *dldataset.ProgressTracker
+	if isLocal {
+		var total int64
+		if info, err := os.Stat(localPath); err == nil {
+			total = info.Size()
+		}
+		tracker = dldataset.NewProgressTracker(progressFunc, canonicalName, fileName, url, total)
+		if err := copyFile(localPath, tempPath, tracker); err != nil {
+			return "", false, err
+		}
+	} else {
+		var total int64
+		if progressFunc != nil {
+			total = contentLength(ctx, url)
+		}
+		tracker = dldataset.NewProgressTracker(progressFunc, canonicalName, fileName, url, total)
+		stop := func() {}
+		if progressFunc != nil {
+			stop = watchFileSize(tempPath, tracker)
+		}
+		opts = append([]downloadmanager.Option{downloadmanager.Context(ctx)}, opts...)
+		tempPath, _, err = downloadmanager.DownloadFile(url, tempPath, opts...)
+		stop()
+		if err != nil {
+			return "", false, err
+		}
+		if info, err := os.Stat(tempPath); err == nil {
+			tracker.Update(info.Size())
+		}
+	}
+	progress := tracker.Finish()
+	log.WithField("dataset", canonicalName).
+		WithField("file", fileName).
+		WithField("bytes", progress.BytesDone).
+		WithField("rate", progress.Rate).
+		Info("downloaded file")
+
+	if err := dldataset.VerifyFile(tempPath, checksum); err != nil {
+		return "", false, err
+	}
+	if err := os.Rename(tempPath, targetPath); err != nil {
+		return "", false, errors.Wrapf(err, "cannot move the downloaded file %v to %v", tempPath, targetPath)
+	}
+	return targetPath, true, nil
+}
+
+// contentLength returns the size of the file at the url, or zero if the server does not tell
+func contentLength(ctx context.Context, url string) int64 {
+	req, err := http.NewRequest(http.MethodHead, url, nil)
+	if err != nil {
+		return 0
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.ContentLength < 0 {
+		return 0
+	}
+	return resp.ContentLength
+}
+
+// watchFileSize reports the size of the file being downloaded to the tracker until the
+// returned function is called, since the download manager does not report its progress
+func watchFileSize(path string, tracker *dldataset.ProgressTracker) func() {
+	done, stopped := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(stopped)
+		interval := dldataset.ProgressInterval
+		if interval <= 0 {
+			interval = 100 * time.Millisecond
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if info, err := os.Stat(path); err == nil {
+					tracker.Update(info.Size())
+				}
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// copyFile copies the file and reports the bytes written to the progress writer, if there is one
+func copyFile(sourcePath, targetPath string, progress io.Writer) error {
+	source, err := os.Open(sourcePath)
+	if os.IsNotExist(err) {
+		return errors.Wrapf(dldataset.ErrNotDownloaded, "the file %v was not found", sourcePath)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "cannot open %v", sourcePath)
+	}
+	defer source.Close()
+
+	if err := os.MkdirAll(filepath.Dir(targetPath), os.ModePerm); err != nil {
+		return errors.Wrapf(err, "cannot create the directory of %v", targetPath)
+	}
+	target, err := os.Create(targetPath)
+	if err != nil {
+		return errors.Wrapf(err, "cannot create %v", targetPath)
+	}
+	var writer io.Writer = target
+	if progress != nil {
+		writer = io.MultiWriter(target, progress)
+	}
+	if _, err := io.Copy(writer, source); err != nil {
+		target.Close()
+		return errors.Wrapf(err, "cannot copy %v to %v", sourcePath, targetPath)
+	}
+	return target.Close()
+}
+
+// newDatasetFiles describes the files of the dataset, whose paths can be overridden by the
+// dataset's config. The urls map the names of the files to the urls they were downloaded or
+// extracted from.
+func newDatasetFiles(workingDir, canonicalName string, urls map[string]string) dldataset.DatasetFiles {
+	paths := make(map[string]string, len(urls))
+	for fileName := range urls {
+		paths[fileName] = datasetFilePath(workingDir, canonicalName, fileName)
+	}
+	return dldataset.DatasetFiles{
+		Dataset:    canonicalName,
+		WorkingDir: workingDir,
+		Paths:      paths,
+		URLs:       urls,
+	}
+}
+
+// moveExtractedFile moves a file out of the directory of an extracted archive. The file is
+// copied instead if the dataset's config keeps the extracted archive.
+func moveExtractedFile(canonicalName, sourcePath, targetPath string) error {
+	if err := os.MkdirAll(filepath.Dir(targetPath), os.ModePerm); err != nil {
+		return errors.Wrapf(err, "cannot create the directory for %s", targetPath)
+	}
+	if dldataset.Config.Dataset(canonicalName).KeepExtracted {
+		return copyFile(sourcePath, targetPath, nil)
+	}
+	if err := os.Rename(sourcePath, targetPath); err != nil {
+		return errors.Wrapf(err, "cannot move the file %s to %s", sourcePath, targetPath)
+	}
+	return nil
+}
+
+// verifyFiles checks the files of the dataset against their checksums in the order of their
+// names. The paths and the checksums of the files can be overridden by the dataset's config.
+// A file without a checksum is reported as unverified, or fails the verification with
+// dldataset.ErrUnverified if the config requires checksums.
+func verifyFiles(workingDir, canonicalName string, checksums map[string]dldataset.Checksum) error {
+	datasetConfig := dldataset.Config.Dataset(canonicalName)
+	fileNames := make([]string, 0, len(checksums))
+	for fileName := range checksums {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+	for _, fileName := range fileNames {
+		checksum := checksums[fileName]
+		if override, ok := datasetConfig.Checksum(fileName); ok {
+			checksum = override
+		}
+		if err := dldataset.VerifyFile(datasetFilePath(workingDir, canonicalName, fileName), checksum); err != nil {
+			return err
+		}
+		if !checksum.IsZero() {
+			continue
+		}
+		if dldataset.Config.RequireChecksums {
+			return errors.Wrapf(dldataset.ErrUnverified, "no checksum is recorded for %v of the %v dataset, add it to the checksums of the dataset's config", fileName, canonicalName)
+		}
+		log.WithField("dataset", canonicalName).
+			WithField("file", fileName).
+			Warn("no checksum is recorded for the file, so it was only checked to exist")
+	}
+	return nil
+}
+
+// labelClasses returns the classes of a label file with one class per line
+func labelClasses(labels []string) *dldataset.ClassMap {
+	classes := []dldataset.Class{}
+	for ii, label := range labels {
+		if strings.TrimSpace(label) == "" {
+			continue
+		}
+		classes = append(classes, dldataset.Class{
+			Index: ii,
+			Name:  label,
+		})
+	}
+	return dldataset.NewClassMap(classes)
+}
+
+// labelMapClasses returns the classes of an object detection label map, whose ids are not contiguous
+func labelMapClasses(labelMap *object_detection.StringIntLabelMap) *dldataset.ClassMap {
+	classes := make([]dldataset.Class, 0, len(labelMap.GetItem()))
+	for _, item := range labelMap.GetItem() {
+		class := dldataset.Class{
+			Index: int(item.GetId()),
+			Name:  item.GetName(),
+		}
+		if displayName := item.GetDisplayName(); displayName != "" {
+			class.Name = displayName
+			class.ID = item.GetName()
+		}
+		classes = append(classes, class)
+	}
+	return dldataset.NewClassMap(classes)
+}
+
+func imageMetadata(name string, img *types.RGBImage) dldataset.Metadata {
+	metadata := dldataset.Metadata{
+		ID: name,
+	}
+	if img != nil {
+		metadata.Width = img.Bounds().Dx()
+		metadata.Height = img.Bounds().Dy()
+	}
+	return metadata
+}
+
 func getImageRecord(data []byte, format string) (*types.RGBImage, error) {
 	img, err := image.Read(bytes.NewBuffer(data), image.Context(nil))
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(dldataset.ErrCorruptRecord, err.Error())
 	}
 
 	rgbImage, ok := img.(*types.RGBImage)
 	if !ok {
-		return nil, errors.Errorf("expecting an rgb image")
+		return nil, errors.Wrap(dldataset.ErrNotSupported, "expecting an rgb image")
 	}
 
 	return rgbImage, nil
@@ -0,0 +1,208 @@
+package vision
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	context "context"
+
+	"github.com/rai-project/dldataset"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDownloadFileFromMirror ...
+func TestDownloadFileFromMirror(t *testing.T) {
+	ctx := context.Background()
+	defer func(offline bool, mirror string) {
+		dldataset.Config.Offline, dldataset.Config.MirrorURL = offline, mirror
+	}(dldataset.Config.Offline, dldataset.Config.MirrorURL)
+
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	mirrorDir := filepath.Join(dir, "mirror")
+	stagedFileName := filepath.Join(mirrorDir, "vision", "coco2014", "coco_val.record-00000-of-00001")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(stagedFileName), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(stagedFileName, []byte("record"), 0644))
+
+	url := "https://s3.amazonaws.com/store.carml.org/datasets/coco2014/coco_val.record-00000-of-00001"
+	targetFileName := filepath.Join(dir, "working", "vision", "coco2014", "coco_val.record-00000-of-00001")
+
+	dldataset.Config.Offline, dldataset.Config.MirrorURL = true, ""
+	_, _, err = downloadFile(ctx, "vision/coco2014", "coco_val.record-00000-of-00001", url, targetFileName, dldataset.Checksum{})
+	assert.True(t, dldataset.IsOffline(err))
+	assert.False(t, dldataset.CanDownload("vision/coco2014"))
+
+	dldataset.Config.MirrorURL = "file://" + filepath.ToSlash(mirrorDir)
+	assert.True(t, dldataset.CanDownload("vision/coco2014"))
+	_, _, err = downloadFile(ctx, "vision/coco2014", "coco_val.record-00000-of-00001", url, targetFileName, dldataset.Checksum{})
+	assert.NoError(t, err)
+	bts, err := ioutil.ReadFile(targetFileName)
+	assert.NoError(t, err)
+	assert.Equal(t, "record", string(bts))
+
+	_, _, err = downloadFile(ctx, "vision/coco2017", "coco_val.record-00000-of-00001", url, targetFileName, dldataset.Checksum{})
+	assert.True(t, dldataset.IsNotDownloaded(err))
+}
+
+// TestDatasetConfigOverrides ...
+func TestDatasetConfigOverrides(t *testing.T) {
+	defer func(datasets map[string]dldataset.DatasetConfig) {
+		dldataset.Config.Datasets = datasets
+	}(dldataset.Config.Datasets)
+
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	dldataset.Config.Datasets = nil
+	assert.Equal(t, filepath.Join(dir, "vision", "coco2014"), datasetWorkingDir(dir, "vision/coco2014"))
+	assert.Equal(t, filepath.Join(dir, "coco_val.record"), datasetFilePath(dir, "vision/coco2014", "coco_val.record"))
+
+	recordsDir := filepath.Join(dir, "records")
+	dldataset.Config.Datasets = map[string]dldataset.DatasetConfig{
+		"vision/coco2014": {
+			WorkingDirectory: recordsDir,
+			Files: map[string]string{
+				"coco_val.record": "coco/val.record",
+			},
+			Checksums: map[string]dldataset.Checksum{
+				// the sha256 sum of "record"
+				"coco_val.record": {SHA256: "70ce871f8a3d3fb449bc3c3ace6547cef02dfc74ffe48d912532a724bfdbe5b9"},
+			},
+		},
+	}
+	workingDir := datasetWorkingDir(dir, "vision/coco2014")
+	assert.Equal(t, recordsDir, workingDir)
+	recordFileName := datasetFilePath(workingDir, "vision/coco2014", "coco_val.record")
+	assert.Equal(t, filepath.Join(recordsDir, "coco", "val.record"), recordFileName)
+
+	assert.NoError(t, os.MkdirAll(filepath.Dir(recordFileName), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(recordFileName, []byte("record"), 0644))
+	// the configured checksum takes precedence over the one of the dataset
+	checksums := map[string]dldataset.Checksum{
+		"coco_val.record": {SHA256: "0000"},
+	}
+	assert.NoError(t, verifyFiles(workingDir, "vision/coco2014", checksums))
+
+	dldataset.Config.Datasets["vision/coco2014"].Checksums["coco_val.record"] = dldataset.Checksum{SHA256: "0000"}
+	assert.True(t, dldataset.IsChecksumMismatch(verifyFiles(workingDir, "vision/coco2014", checksums)))
+}
+
+// TestVerifyFilesUnverified ...
+func TestVerifyFilesUnverified(t *testing.T) {
+	defer func(require bool) {
+		dldataset.Config.RequireChecksums = require
+	}(dldataset.Config.RequireChecksums)
+
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "imagenet1k-val.rec"), []byte("record"), 0644))
+	checksums := map[string]dldataset.Checksum{
+		"imagenet1k-val.rec": {},
+	}
+
+	// a file without a checksum is only checked to exist unless checksums are required
+	dldataset.Config.RequireChecksums = false
+	assert.NoError(t, verifyFiles(dir, "vision/ilsvrc2012_validation", checksums))
+	dldataset.Config.RequireChecksums = true
+	assert.True(t, dldataset.IsUnverified(verifyFiles(dir, "vision/ilsvrc2012_validation", checksums)))
+
+	checksums["imagenet1k-val.rec"] = dldataset.Checksum{SHA256: "70ce871f8a3d3fb449bc3c3ace6547cef02dfc74ffe48d912532a724bfdbe5b9"}
+	assert.NoError(t, verifyFiles(dir, "vision/ilsvrc2012_validation", checksums))
+}
+
+// TestNewDatasetFiles ...
+func TestNewDatasetFiles(t *testing.T) {
+	defer func(datasets map[string]dldataset.DatasetConfig) {
+		dldataset.Config.Datasets = datasets
+	}(dldataset.Config.Datasets)
+	dldataset.Config.Datasets = map[string]dldataset.DatasetConfig{
+		"vision/coco2014": {
+			Files: map[string]string{
+				"coco_val.record": "/data/coco_val.record",
+			},
+		},
+	}
+
+	urls := map[string]string{
+		"coco_val.record": "https://s3.amazonaws.com/store.carml.org/datasets/coco2014/coco_val.record",
+		"labels.txt":      "https://s3.amazonaws.com/store.carml.org/datasets/coco2014/labels.txt",
+	}
+	files := newDatasetFiles("/work/coco2014", "vision/coco2014", urls)
+	assert.Equal(t, "vision/coco2014", files.Dataset)
+	assert.Equal(t, "/work/coco2014", files.WorkingDir)
+	assert.Equal(t, map[string]string{
+		"coco_val.record": "/data/coco_val.record",
+		"labels.txt":      filepath.Join("/work/coco2014", "labels.txt"),
+	}, files.Paths)
+	assert.Equal(t, urls, files.URLs)
+}
+
+// TestDownloadFileChecksum ...
+func TestDownloadFileChecksum(t *testing.T) {
+	ctx := context.Background()
+	defer func(offline bool, mirror string) {
+		dldataset.Config.Offline, dldataset.Config.MirrorURL = offline, mirror
+	}(dldataset.Config.Offline, dldataset.Config.MirrorURL)
+
+	dir, err := ioutil.TempDir("", "dldataset")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	mirrorDir := filepath.Join(dir, "mirror")
+	stagedFileName := filepath.Join(mirrorDir, "vision", "cifar10", "cifar-10-binary.tar.gz")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(stagedFileName), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(stagedFileName, []byte("hello world"), 0644))
+	dldataset.Config.Offline, dldataset.Config.MirrorURL = true, mirrorDir
+
+	url := "https://www.cs.toronto.edu/~kriz/cifar-10-binary.tar.gz"
+	workingDir := filepath.Join(dir, "working")
+	targetFileName := filepath.Join(workingDir, "cifar-10-binary.tar.gz")
+
+	// a file that does not match its checksum never replaces the target file
+	_, _, err = downloadFile(ctx, "vision/cifar10", "cifar-10-binary.tar.gz", url, targetFileName, dldataset.Checksum{MD5: "0000"})
+	assert.True(t, dldataset.IsChecksumMismatch(err))
+	_, err = os.Stat(targetFileName)
+	assert.True(t, os.IsNotExist(err))
+	entries, err := ioutil.ReadDir(workingDir)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	var progress dldataset.DownloadProgress
+	progressCtx := dldataset.WithProgress(ctx, func(p dldataset.DownloadProgress) {
+		progress = p
+	})
+	checksum := dldataset.Checksum{MD5: "5eb63bbbe01eeed093cb22bb8f5acdc3"}
+	_, downloaded, err := downloadFile(progressCtx, "vision/cifar10", "cifar-10-binary.tar.gz", url, targetFileName, checksum)
+	assert.NoError(t, err)
+	assert.True(t, downloaded)
+	assert.True(t, progress.Done)
+	assert.Equal(t, int64(11), progress.BytesDone)
+	assert.Equal(t, int64(11), progress.BytesTotal)
+	_, downloaded, err = downloadFile(ctx, "vision/cifar10", "cifar-10-binary.tar.gz", url, targetFileName, checksum)
+	assert.NoError(t, err)
+	assert.False(t, downloaded)
+}
+
+// TestCheckObjectCount ...
+func TestCheckObjectCount(t *testing.T) {
+	assert.NoError(t, checkObjectCount("000001.jpg", 2, map[string]int{
+		"image/object/bbox/xmin": 2,
+		"image/object/view":      2,
+	}))
+	assert.NoError(t, checkObjectCount("000001.jpg", 0, map[string]int{
+		"image/object/view": 0,
+	}))
+
+	err := checkObjectCount("000001.jpg", 3, map[string]int{
+		"image/object/bbox/xmin": 3,
+		"image/object/view":      1,
+	})
+	assert.True(t, dldataset.IsCorruptRecord(err))
+	assert.Contains(t, err.Error(), "image/object/view")
+}